@@ -0,0 +1,131 @@
+package bass
+
+import (
+	"sync"
+	"time"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/28
+ * Time: 20:40
+ * Description: 自动滚动引擎，按固定帧率推进滚动位置并在跨页时自动翻页
+ */
+
+// autoScrollFrameRate 自动滚动的刷新帧率
+const autoScrollFrameRate = 60
+
+// autoScrollInterval 自动滚动两次推进之间的间隔，由 autoScrollFrameRate 换算得出
+const autoScrollInterval = time.Second / autoScrollFrameRate
+
+// autoScroller 自动滚动的运行状态，随 Booker.StartAutoScroll 创建，随 Booker.StopAutoScroll 销毁
+type autoScroller struct {
+	stop chan struct{} // 关闭时停止后台 goroutine
+
+	mu     sync.Mutex // 保护 paused 与 offset 不被后台 goroutine 与调用方并发读写
+	paused bool       // 暂停时仍持有 goroutine，只是跳过推进
+	offset float32    // 当前页内累计滚动的偏移量
+}
+
+// StartAutoScroll 启动自动滚动，非阅读状态或已在运行时不做任何改动
+func (b *Booker) StartAutoScroll() {
+	b.mu.Lock()
+	if b.readStatusLocked() != StatusReading || b.autoScroll != nil {
+		b.mu.Unlock()
+		return
+	}
+	a := &autoScroller{stop: make(chan struct{})}
+	b.autoScroll = a
+	b.autoScrollEnabled = true
+	b.mu.Unlock()
+
+	go b.runAutoScroll(a)
+}
+
+// PauseAutoScroll 暂停自动滚动，不停止后台 goroutine，可通过 ResumeAutoScroll 恢复
+func (b *Booker) PauseAutoScroll() {
+	b.mu.Lock()
+	a := b.autoScroll
+	b.mu.Unlock()
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.paused = true
+	a.mu.Unlock()
+}
+
+// ResumeAutoScroll 恢复已暂停的自动滚动；尚未启动时不做任何改动
+func (b *Booker) ResumeAutoScroll() {
+	b.mu.Lock()
+	a := b.autoScroll
+	b.mu.Unlock()
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.paused = false
+	a.mu.Unlock()
+}
+
+// StopAutoScroll 停止自动滚动并释放后台 goroutine；尚未启动时不做任何改动
+func (b *Booker) StopAutoScroll() {
+	b.mu.Lock()
+	a := b.autoScroll
+	if a == nil {
+		b.mu.Unlock()
+		return
+	}
+	b.autoScroll = nil
+	b.autoScrollEnabled = false
+	b.mu.Unlock()
+
+	close(a.stop)
+}
+
+// runAutoScroll 以 autoScrollFrameRate 为帧率驱动 a，直至 StopAutoScroll 关闭 a.stop
+func (b *Booker) runAutoScroll(a *autoScroller) {
+	ticker := time.NewTicker(autoScrollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+			b.tickAutoScroll(a)
+		}
+	}
+}
+
+// tickAutoScroll 推进一帧：按当前 scrollSpeed、pageMargin 计算偏移增量，触发滚动回调，
+// 累计偏移跨过一页的高度后自动翻到下一页并保留余下的偏移量，使滚动手感保持连续
+func (b *Booker) tickAutoScroll(a *autoScroller) {
+	a.mu.Lock()
+	paused := a.paused
+	a.mu.Unlock()
+	if paused || b.readStatus() != StatusReading {
+		return
+	}
+
+	b.mu.Lock()
+	speed := b.scrollSpeed
+	margin := b.pageMargin
+	b.mu.Unlock()
+
+	lineHeight := DefaultLineHeight + margin
+	perTick := speed * lineHeight / autoScrollFrameRate
+
+	a.mu.Lock()
+	a.offset += perTick
+	offset := a.offset
+	if offset >= PageHeight {
+		a.offset -= PageHeight
+	}
+	a.mu.Unlock()
+
+	b.UpdateScroll(offset)
+	if offset >= PageHeight {
+		b.NextPage()
+	}
+}
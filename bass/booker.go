@@ -8,10 +8,23 @@ package bass
  */
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
 	"sort"
+	"sync"
 	"time"
+
+	"goread/bass/parser"
+	"goread/bass/search"
+	"goread/bass/source"
+	"goread/bass/store"
 )
 
+// scrollPersistInterval 同一本书连续滚动时，两次持久化阅读进度之间的最短间隔
+const scrollPersistInterval = 2 * time.Second
+
 // BookerSettings 阅读器设置
 type BookerSettings struct {
 	readStyle         ScrollType // 阅读方式
@@ -35,6 +48,32 @@ type Booker struct {
 	queue             []BookMeta   // 书籍元数据
 	currentQueueIndex int          // 当前正在阅读的队列索引
 	status            ReaderStatus // 当前阅读器状态
+
+	// 解析结果缓存，key 为文件路径
+	books map[string]*parser.Book
+
+	// 书签、高亮与阅读进度的持久化实现，未设置时只保存在内存中
+	store store.Store
+
+	// 上次持久化滚动位置的时间，key 为文件路径，用于节流 UpdateScroll 触发的写入
+	scrollPersistedAt map[string]time.Time
+
+	// RestoreQueue 批量重新打开队列中的书籍时临时挂起 persistQueue，避免逐本重复覆盖队列存储
+	suspendQueuePersist bool
+
+	// 书架分组
+	groups     []BookGroup
+	groupStore store.GroupStore
+
+	// 全文检索索引，未设置时 Search 不返回任何结果
+	searchIndex *search.SearchIndex
+
+	// 自动滚动运行状态，未启动时为 nil
+	autoScroll *autoScroller
+
+	// 保护 status、queue/currentQueueIndex、scrollPersistedAt 及 BookerSettings 字段，
+	// 这些状态会被自动滚动的后台 goroutine 与调用方并发读写
+	mu sync.Mutex
 }
 
 // BookerCallback 阅读器回调接口
@@ -52,9 +91,19 @@ type BookerCallback struct {
 	bookmarkRemoveCallback func(page int)              // 移除书签回调
 	bookmarkLoadCallback   func(bookmarks []BookMark)  // 加载书签回调
 
+	// 高亮相关回调
+	highlightAddCallback    func(highlight Highlight) // 新增高亮回调
+	highlightRemoveCallback func(id string)           // 移除高亮回调
+
 	// 设置相关回调
 	settingsChangeCallback func(settings *BookerSettings) // 设置变更回调
 
+	// 分组相关回调
+	groupChangeCallback func(groups []BookGroup) // 分组列表变化回调
+
+	// 在线书源相关回调
+	downloadProgressCallback func(progress float32) // 在线书籍抓取进度回调（0-100%），与阅读进度相互独立
+
 	// 文件相关回调
 	fileOpenCallback  func(meta *BookMeta) // 文件打开回调
 	fileCloseCallback func()               // 文件关闭回调
@@ -74,39 +123,68 @@ func NewBooker(callback BookerCallback) *Booker {
 			scrollSpeed:       1.0,
 			pageMargin:        0.0,
 		},
-		queue:          make([]BookMeta, 0),
-		BookerCallback: callback,
+		queue:             make([]BookMeta, 0),
+		BookerCallback:    callback,
+		books:             make(map[string]*parser.Book),
+		scrollPersistedAt: make(map[string]time.Time),
 	}
 }
 
-// OpenBook 打开书籍
+// pageLayout 返回用于分页的默认版式参数
+func pageLayout() parser.PageLayout {
+	return parser.PageLayout{
+		FontSize:   DefaultFontSize,
+		LineHeight: DefaultLineHeight,
+		PageWidth:  PageWidth,
+		PageHeight: PageHeight,
+	}
+}
+
+// OpenBook 打开书籍，按扩展名路由到对应的解析器完成分页后才触发回调
 func (b *Booker) OpenBook(filePath string) error {
 	// 更新状态为加载中
 	b.setStatus(StatusLoading)
 
-	// TODO: 实现文件加载逻辑
+	book, err := parser.ParseAndPaginate(filePath, pageLayout())
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+	b.books[filePath] = book
+	b.indexForSearch(filePath, book)
+
 	meta := &BookMeta{
-		FilePath: filePath,
-		// ... 其他元数据初始化
+		FilePath:    filePath,
+		Name:        book.Title,
+		CoverPath:   book.CoverPath,
+		TotalPage:   book.TotalPage(),
+		CurrentPage: 1,
+		LastPage:    1,
+		LastRead:    time.Now(),
+		ScaleFactor: 1.0,
 	}
 
-	// 添加到队列并触发回调
+	// 添加到队列
+	b.mu.Lock()
 	b.queue = append(b.queue, *meta)
 	b.currentQueueIndex = len(b.queue) - 1
+	b.mu.Unlock()
+	b.persistQueue()
 	if b.fileAddCallback != nil {
 		b.fileAddCallback(meta)
 	}
 
-	// 触发文件打开回调
+	// 解析成功后才触发文件打开回调
 	if b.fileOpenCallback != nil {
 		b.fileOpenCallback(meta)
 	}
 
-	// 加载书签并触发回调
-	if b.bookmarkLoadCallback != nil {
-		// TODO: 从存储中加载书签
-		bookmarks := []BookMark{}
-		b.bookmarkLoadCallback(bookmarks)
+	// 加载书签与高亮并触发回调，需作用于队列中的副本，这样 Highlights 才对 GetCurrentBook 可见
+	if queued := b.GetCurrentBook(); queued != nil {
+		bookmarks := b.loadAnnotations(queued)
+		if b.bookmarkLoadCallback != nil {
+			b.bookmarkLoadCallback(bookmarks)
+		}
 	}
 
 	// 更新状态为阅读中
@@ -114,12 +192,116 @@ func (b *Booker) OpenBook(filePath string) error {
 	return nil
 }
 
+// AddFromURL 按书籍地址的 host 找到对应的 NovelSource，拉取目录后并发抓取每一章正文，
+// 逐章落盘，抓取进度通过 downloadProgressCallback 汇报，全部完成后才加入队列
+func (b *Booker) AddFromURL(bookURL string) error {
+	b.setStatus(StatusLoading)
+
+	src, err := source.ForURL(bookURL)
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+
+	toc, err := src.FetchTOC(bookURL)
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+
+	destDir, err := novelCacheDir(bookURL)
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+
+	crawler := source.NewCrawler(source.DefaultConcurrency, source.DefaultRateLimit)
+	paths, err := crawler.Fetch(src, toc, destDir, func(done, total int) {
+		if b.downloadProgressCallback != nil {
+			b.downloadProgressCallback(float32(done) / float32(total) * 100)
+		}
+	})
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+
+	book, err := assembleCrawledBook(toc, paths)
+	if err != nil {
+		b.setStatus(StatusIdle)
+		return err
+	}
+	if book.Title == "" {
+		book.Title = bookURL
+	}
+	parser.Paginate(book, pageLayout())
+	b.books[bookURL] = book
+	b.indexForSearch(bookURL, book)
+
+	meta := &BookMeta{
+		FilePath:    bookURL,
+		Name:        book.Title,
+		TotalPage:   book.TotalPage(),
+		CurrentPage: 1,
+		LastPage:    1,
+		LastRead:    time.Now(),
+		ScaleFactor: 1.0,
+	}
+
+	b.mu.Lock()
+	b.queue = append(b.queue, *meta)
+	b.currentQueueIndex = len(b.queue) - 1
+	b.mu.Unlock()
+	b.persistQueue()
+	if b.fileAddCallback != nil {
+		b.fileAddCallback(meta)
+	}
+	if b.fileOpenCallback != nil {
+		b.fileOpenCallback(meta)
+	}
+
+	b.setStatus(StatusReading)
+	return nil
+}
+
+// novelCacheDir 返回 bookURL 对应的落章目录，按 URL 哈希分目录，避免不同书籍互相覆盖
+func novelCacheDir(bookURL string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(bookURL))
+	return filepath.Join(home, ".goread/novels", hex.EncodeToString(sum[:])), nil
+}
+
+// assembleCrawledBook 把抓取到的章节标题与落盘后的正文文件组装成 parser.Book
+func assembleCrawledBook(toc []source.Chapter, paths []string) (*parser.Book, error) {
+	chapters := make([]parser.Chapter, len(paths))
+	for i, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		chapters[i] = parser.Chapter{Title: toc[i].Title, Content: string(content)}
+	}
+	return &parser.Book{Chapters: chapters}, nil
+}
+
 // CloseBook 关闭当前书籍
 func (b *Booker) CloseBook() {
-	if b.status == StatusIdle {
+	if b.readStatus() == StatusIdle {
 		return
 	}
 
+	// 在锁内删除，避免自动滚动的后台 goroutine 与这里并发读写
+	// scrollPersistedAt，出现 fatal: concurrent map writes
+	b.mu.Lock()
+	if meta := b.currentBookLocked(); meta != nil {
+		delete(b.scrollPersistedAt, meta.FilePath)
+	}
+	b.mu.Unlock()
+	b.StopAutoScroll()
+
 	// 触发关闭回调
 	if b.fileCloseCallback != nil {
 		b.fileCloseCallback()
@@ -130,75 +312,130 @@ func (b *Booker) CloseBook() {
 
 // NextPage 下一页
 func (b *Booker) NextPage() {
-	if b.status != StatusReading {
+	if b.readStatus() != StatusReading {
 		return
 	}
-
-	// TODO: 实现翻到下一页的逻辑
-	currentPage := 1  // 示例值
-	totalPages := 100 // 示例值
-
-	// 触发页面变化回调
-	if b.pageChangeCallback != nil {
-		b.pageChangeCallback(currentPage, totalPages)
-	}
-
-	// 更新进度
-	if b.progressUpdateCallback != nil {
-		progress := float32(currentPage) / float32(totalPages) * 100
-		b.progressUpdateCallback(progress)
+	book := b.GetCurrentBook()
+	if book == nil {
+		return
 	}
+	b.gotoPage(book, book.CurrentPage+1)
 }
 
 // PrevPage 上一页
 func (b *Booker) PrevPage() {
-	if b.status != StatusReading {
+	if b.readStatus() != StatusReading {
+		return
+	}
+	book := b.GetCurrentBook()
+	if book == nil {
 		return
 	}
+	b.gotoPage(book, book.CurrentPage-1)
+}
 
-	// TODO: 实现翻到上一页的逻辑
-	currentPage := 1  // 示例值
-	totalPages := 100 // 示例值
+// JumpToPage 跳转到指定页
+func (b *Booker) JumpToPage(pageNum int) {
+	if b.readStatus() != StatusReading {
+		return
+	}
+	book := b.GetCurrentBook()
+	if book == nil {
+		return
+	}
+	b.PauseAutoScroll()
+	b.gotoPage(book, pageNum)
+}
+
+// gotoPage 将 meta 所指的当前书籍翻到 pageNum 页，并触发页面与进度回调；meta 的字段写入与
+// 持久化在锁内完成，避免与自动滚动的后台 goroutine 并发修改同一本书
+func (b *Booker) gotoPage(meta *BookMeta, pageNum int) {
+	b.mu.Lock()
+	if pageNum < 1 {
+		pageNum = 1
+	}
+	if meta.TotalPage > 0 && pageNum > meta.TotalPage {
+		pageNum = meta.TotalPage
+	}
+	meta.CurrentPage = pageNum
+	meta.LastPage = pageNum
+	meta.LastRead = time.Now()
+	if meta.TotalPage > 0 {
+		meta.Progress = float32(meta.CurrentPage) / float32(meta.TotalPage) * 100
+	}
+	b.persistBookData(meta)
+	currentPage, totalPage, progress := meta.CurrentPage, meta.TotalPage, meta.Progress
+	b.mu.Unlock()
 
 	// 触发页面变化回调
 	if b.pageChangeCallback != nil {
-		b.pageChangeCallback(currentPage, totalPages)
+		b.pageChangeCallback(currentPage, totalPage)
 	}
 
 	// 更新进度
-	if b.progressUpdateCallback != nil {
-		progress := float32(currentPage) / float32(totalPages) * 100
+	if totalPage > 0 && b.progressUpdateCallback != nil {
 		b.progressUpdateCallback(progress)
 	}
 }
 
-// JumpToPage 跳转到指定页
-func (b *Booker) JumpToPage(pageNum int) {
-	if b.status != StatusReading {
+// SetScale 按 scale 重新计算当前书籍的分页（缩放越大单页能容纳的正文越少，页数越多），
+// 并把 scale 持久化到 BookMeta.ScaleFactor；当前页按原阅读进度比例换算到新的总页数，
+// 使缩放前后的阅读位置尽量保持一致
+func (b *Booker) SetScale(scale float32) {
+	if b.readStatus() != StatusReading {
+		return
+	}
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	book, ok := b.books[meta.FilePath]
+	if !ok {
 		return
 	}
 
-	// TODO: 实现跳转到指定页的逻辑
-	currentPage := pageNum
-	totalPages := 100 // 示例值
+	oldTotal, oldCurrent := meta.TotalPage, meta.CurrentPage
+	parser.RepaginateForScale(book, scale)
 
-	// 触发页面变化回调
-	if b.pageChangeCallback != nil {
-		b.pageChangeCallback(currentPage, totalPages)
+	b.mu.Lock()
+	meta.ScaleFactor = scale
+	meta.TotalPage = book.TotalPage()
+	if oldTotal > 0 {
+		meta.CurrentPage = int(float32(oldCurrent)/float32(oldTotal)*float32(meta.TotalPage) + 0.5)
+	}
+	if meta.CurrentPage < 1 {
+		meta.CurrentPage = 1
+	}
+	if meta.TotalPage > 0 && meta.CurrentPage > meta.TotalPage {
+		meta.CurrentPage = meta.TotalPage
+	}
+	meta.LastPage = meta.CurrentPage
+	if meta.TotalPage > 0 {
+		meta.Progress = float32(meta.CurrentPage) / float32(meta.TotalPage) * 100
 	}
+	b.persistBookData(meta)
+	currentPage, totalPage, progress := meta.CurrentPage, meta.TotalPage, meta.Progress
+	b.mu.Unlock()
 
-	// 更新进度
-	if b.progressUpdateCallback != nil {
-		progress := float32(currentPage) / float32(totalPages) * 100
+	if b.pageChangeCallback != nil {
+		b.pageChangeCallback(currentPage, totalPage)
+	}
+	if totalPage > 0 && b.progressUpdateCallback != nil {
 		b.progressUpdateCallback(progress)
 	}
 }
 
-// AddBookmark 添加书签
+// AddBookmark 添加书签，写入当前书籍并持久化到 Store（若已配置）
 func (b *Booker) AddBookmark(page int, desc string) {
 	if b.status != StatusReading {
 		return
 	}
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	meta.Bookmarks = append(meta.Bookmarks, BookMark{Page: page, Desc: desc, CreatedAt: time.Now()})
+	b.persistBookData(meta)
 
 	// 触发添加书签回调
 	if b.bookmarkAddCallback != nil {
@@ -206,11 +443,22 @@ func (b *Booker) AddBookmark(page int, desc string) {
 	}
 }
 
-// RemoveBookmark 移除书签
+// RemoveBookmark 按页码移除当前书籍的书签，并持久化到 Store（若已配置）
 func (b *Booker) RemoveBookmark(page int) {
 	if b.status != StatusReading {
 		return
 	}
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	for i, m := range meta.Bookmarks {
+		if m.Page == page {
+			meta.Bookmarks = append(meta.Bookmarks[:i], meta.Bookmarks[i+1:]...)
+			break
+		}
+	}
+	b.persistBookData(meta)
 
 	// 触发移除书签回调
 	if b.bookmarkRemoveCallback != nil {
@@ -218,9 +466,16 @@ func (b *Booker) RemoveBookmark(page int) {
 	}
 }
 
-// UpdateSettings 更新阅读器设置
+// UpdateSettings 更新阅读器设置并持久化到 Store（若已配置）；scrollSpeed、pageMargin 对正在
+// 运行的自动滚动即时生效，无需重启 ticker
 func (b *Booker) UpdateSettings(settings BookerSettings) {
+	b.mu.Lock()
 	b.BookerSettings = settings
+	b.mu.Unlock()
+
+	if b.store != nil {
+		_ = b.store.SaveSettings(toStoreSettings(&settings))
+	}
 
 	// 触发设置变更回调
 	if b.settingsChangeCallback != nil {
@@ -230,27 +485,422 @@ func (b *Booker) UpdateSettings(settings BookerSettings) {
 
 // GetCurrentBook 获取当前正在阅读的书籍
 func (b *Booker) GetCurrentBook() *BookMeta {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentBookLocked()
+}
+
+// currentBookLocked 是 GetCurrentBook 的无锁版本，仅供已持有 mu 的调用方使用
+func (b *Booker) currentBookLocked() *BookMeta {
 	if len(b.queue) == 0 || b.currentQueueIndex < 0 || b.currentQueueIndex >= len(b.queue) {
 		return nil
 	}
 	return &b.queue[b.currentQueueIndex]
 }
 
-// setStatus 设置阅读器状态
+// PageText 返回当前书籍中指定页码的正文内容，书籍未解析或页码越界时返回空字符串
+func (b *Booker) PageText(pageNum int) string {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return ""
+	}
+	book, ok := b.books[meta.FilePath]
+	if !ok {
+		return ""
+	}
+	return book.PageText(pageNum)
+}
+
+// CurrentPageText 返回当前页的正文内容
+func (b *Booker) CurrentPageText() string {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return ""
+	}
+	return b.PageText(meta.CurrentPage)
+}
+
+// CurrentPageRange 返回当前页所在的章节下标及该页在章节正文内的字符偏移区间
+func (b *Booker) CurrentPageRange() (chapterIndex, start, end int, ok bool) {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return 0, 0, 0, false
+	}
+	book, exists := b.books[meta.FilePath]
+	if !exists {
+		return 0, 0, 0, false
+	}
+	return book.PageRange(meta.CurrentPage)
+}
+
+// SetStore 设置书签、高亮与阅读进度的持久化实现，未设置时这些数据只保存在内存中
+func (b *Booker) SetStore(s store.Store) {
+	b.store = s
+}
+
+// RestoreSettings 从 Store 读取上次保存的阅读器设置并应用；未配置 Store、尚未保存过或加载失败时不做任何改动
+func (b *Booker) RestoreSettings() {
+	if b.store == nil {
+		return
+	}
+	settings, err := b.store.LoadSettings()
+	if err != nil || settings == nil {
+		return
+	}
+	b.BookerSettings = fromStoreSettings(settings)
+}
+
+// RestoreQueue 从 Store 读取上次保存的书架队列顺序，依次调用 OpenBook 重新打开每本书，
+// 从而一并恢复阅读进度、书签与高亮；未配置 Store 或读取失败时不做任何改动
+func (b *Booker) RestoreQueue() {
+	if b.store == nil {
+		return
+	}
+	paths, err := b.store.LoadQueue()
+	if err != nil {
+		return
+	}
+
+	// OpenBook 本身会在每次成功后调用 persistQueue，恢复期间暂时挂起，避免重复整体覆盖队列文件
+	b.suspendQueuePersist = true
+	for _, path := range paths {
+		_ = b.OpenBook(path)
+	}
+	b.suspendQueuePersist = false
+	b.persistQueue()
+}
+
+// persistQueue 把当前队列的书籍文件路径顺序写入 Store，未配置 Store 或正处于 RestoreQueue 恢复期间时忽略
+func (b *Booker) persistQueue() {
+	if b.store == nil || b.suspendQueuePersist {
+		return
+	}
+	paths := make([]string, len(b.queue))
+	for i, meta := range b.queue {
+		paths[i] = meta.FilePath
+	}
+	_ = b.store.SaveQueue(paths)
+}
+
+// SetSearchIndex 设置全文检索索引，未设置时 Search 不返回任何结果
+func (b *Booker) SetSearchIndex(idx *search.SearchIndex) {
+	b.searchIndex = idx
+}
+
+// SetDownloadProgressCallback 设置在线书籍抓取进度回调，未设置时 AddFromURL 的抓取进度不会对外暴露
+func (b *Booker) SetDownloadProgressCallback(fn func(progress float32)) {
+	b.downloadProgressCallback = fn
+}
+
+// SetScrollChangeCallback 设置滚动位置变化回调，未设置时 UpdateScroll（含自动滚动）的偏移量不会对外暴露
+func (b *Booker) SetScrollChangeCallback(fn func(offset float32)) {
+	b.scrollChangeCallback = fn
+}
+
+// indexForSearch 把刚解析完成的 book 计入检索索引，未配置索引时忽略；内容相对已有索引没有变化时
+// 直接跳过，避免重新打开一本书就触发 Remove 对整个书架的全量重新分词。只有内容确实发生变化（或
+// 这本书还未建过索引）时才先移除旧文档再重新计入，避免同一本书在索引中重复
+func (b *Booker) indexForSearch(bookID string, book *parser.Book) {
+	if b.searchIndex == nil {
+		return
+	}
+	chapters := toSearchChapters(book)
+	if !b.searchIndex.NeedsIndex(bookID, chapters) {
+		return
+	}
+	_ = b.searchIndex.Remove(bookID)
+	_ = b.searchIndex.IndexBook(bookID, chapters)
+}
+
+// toSearchChapters 把解析结果的章节与分页信息转换为检索索引所需的章节结构
+func toSearchChapters(book *parser.Book) []search.Chapter {
+	chapters := make([]search.Chapter, len(book.Chapters))
+	for i, ch := range book.Chapters {
+		var breaks []int
+		if i < len(book.PageBreaks) {
+			breaks = book.PageBreaks[i]
+		}
+		chapters[i] = search.Chapter{Title: ch.Title, Content: ch.Content, PageBreaks: breaks}
+	}
+	return chapters
+}
+
+// SearchResult 一条检索结果，在 search.SearchHit 的基础上附加命中书籍的元数据；
+// Book 在该书不在当前队列中时为 nil
+type SearchResult struct {
+	search.SearchHit
+	Book *BookMeta
+}
+
+// Search 对书架做全文检索，返回的结果附带命中书籍的 BookMeta；未配置检索索引时返回空结果
+func (b *Booker) Search(q string, opts search.QueryOptions) ([]SearchResult, error) {
+	if b.searchIndex == nil {
+		return nil, nil
+	}
+	hits, err := b.searchIndex.Query(q, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = SearchResult{SearchHit: hit, Book: b.findBook(hit.BookID)}
+	}
+	return results, nil
+}
+
+// JumpToHit 把 hit 所在的书籍切换为当前书籍并跳转到命中所在页；hit 对应的书籍不在当前队列中时不做任何改动
+func (b *Booker) JumpToHit(hit search.SearchHit) {
+	for i := range b.queue {
+		if b.queue[i].FilePath != hit.BookID {
+			continue
+		}
+		b.mu.Lock()
+		b.currentQueueIndex = i
+		b.mu.Unlock()
+		b.setStatus(StatusReading)
+		b.JumpToPage(hit.Page)
+		return
+	}
+}
+
+// AddHighlight 在当前书中新增一条高亮，并持久化到 Store（若已配置）
+func (b *Booker) AddHighlight(h Highlight) {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	meta.Highlights = append(meta.Highlights, h)
+	b.persistBookData(meta)
+
+	if b.highlightAddCallback != nil {
+		b.highlightAddCallback(h)
+	}
+}
+
+// RemoveHighlight 按 ID 移除当前书中的一条高亮
+func (b *Booker) RemoveHighlight(id string) {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	for i, h := range meta.Highlights {
+		if h.ID == id {
+			meta.Highlights = append(meta.Highlights[:i], meta.Highlights[i+1:]...)
+			break
+		}
+	}
+	b.persistBookData(meta)
+
+	if b.highlightRemoveCallback != nil {
+		b.highlightRemoveCallback(id)
+	}
+}
+
+// HighlightsForPage 返回落在指定全局页码范围内的高亮，供阅读视图叠加底色
+func (b *Booker) HighlightsForPage(page int) []Highlight {
+	meta := b.GetCurrentBook()
+	if meta == nil {
+		return nil
+	}
+	book, exists := b.books[meta.FilePath]
+	if !exists {
+		return nil
+	}
+
+	chapterIndex, start, end, ok := book.PageRange(page)
+	if !ok {
+		return nil
+	}
+
+	var result []Highlight
+	for _, h := range meta.Highlights {
+		if h.ChapterIndex != chapterIndex {
+			continue
+		}
+		if h.StartOffset < end && h.EndOffset > start {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// loadAnnotations 从 Store 读取 meta 对应书籍已持久化的书签、高亮与阅读进度，写回 meta 并返回书签列表；
+// 未配置 Store、meta 为空或加载失败时返回空切片。CurrentPage 为 0 说明书籍从未持久化过，
+// 此时保留 OpenBook 刚写入的初始值，不做覆盖
+func (b *Booker) loadAnnotations(meta *BookMeta) []BookMark {
+	if b.store == nil || meta == nil {
+		return []BookMark{}
+	}
+
+	data, err := b.store.Load(meta.FilePath)
+	if err != nil {
+		return []BookMark{}
+	}
+
+	meta.Highlights = fromStoreHighlights(data.Highlights)
+	meta.Bookmarks = fromStoreBookmarks(data.Bookmarks)
+	if data.CurrentPage > 0 {
+		meta.CurrentPage = data.CurrentPage
+		meta.LastPage = data.LastPage
+		meta.Progress = data.Progress
+		meta.LastRead = data.LastRead
+		meta.ScaleFactor = data.ScaleFactor
+	}
+	return meta.Bookmarks
+}
+
+// persistBookData 把 meta 当前的书签、高亮与阅读进度写入 Store，未配置 Store 时忽略
+func (b *Booker) persistBookData(meta *BookMeta) error {
+	if b.store == nil || meta == nil {
+		return nil
+	}
+
+	data := &store.BookData{
+		Bookmarks:   toStoreBookmarks(meta.Bookmarks),
+		Highlights:  toStoreHighlights(meta.Highlights),
+		CurrentPage: meta.CurrentPage,
+		LastPage:    meta.LastPage,
+		Progress:    meta.Progress,
+		LastRead:    meta.LastRead,
+		ScaleFactor: meta.ScaleFactor,
+	}
+	return b.store.Save(meta.FilePath, data)
+}
+
+// persistBookDataThrottled 和 persistBookData 作用相同，但同一本书在 scrollPersistInterval 内
+// 只会真正写入一次，避免连续滚动时频繁触发磁盘 IO；写入失败时不刷新节流时间戳，以便下次滚动重试。
+// scrollPersistedAt 的读写在锁内完成，因为自动滚动的后台 goroutine 也会并发调用本方法
+func (b *Booker) persistBookDataThrottled(meta *BookMeta) {
+	if b.store == nil || meta == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if last, ok := b.scrollPersistedAt[meta.FilePath]; ok && time.Since(last) < scrollPersistInterval {
+		return
+	}
+	if b.persistBookData(meta) == nil {
+		b.scrollPersistedAt[meta.FilePath] = time.Now()
+	}
+}
+
+// toStoreBookmarks 把内存中的书签转换为持久化记录
+func toStoreBookmarks(marks []BookMark) []store.Bookmark {
+	out := make([]store.Bookmark, len(marks))
+	for i, m := range marks {
+		out[i] = store.Bookmark{Page: m.Page, Desc: m.Desc, CreatedAt: m.CreatedAt}
+	}
+	return out
+}
+
+// fromStoreBookmarks 把持久化的书签记录还原为内存结构
+func fromStoreBookmarks(marks []store.Bookmark) []BookMark {
+	out := make([]BookMark, len(marks))
+	for i, m := range marks {
+		out[i] = BookMark{Page: m.Page, Desc: m.Desc, CreatedAt: m.CreatedAt}
+	}
+	return out
+}
+
+// toStoreHighlights 把内存中的高亮转换为持久化记录
+func toStoreHighlights(highlights []Highlight) []store.Highlight {
+	out := make([]store.Highlight, len(highlights))
+	for i, h := range highlights {
+		out[i] = store.Highlight{
+			ID:           h.ID,
+			ChapterIndex: h.ChapterIndex,
+			StartOffset:  h.StartOffset,
+			EndOffset:    h.EndOffset,
+			Color:        h.Color,
+			Note:         h.Note,
+			CreatedAt:    h.CreatedAt,
+			UpdatedAt:    h.UpdatedAt,
+		}
+	}
+	return out
+}
+
+// fromStoreHighlights 把持久化的高亮记录还原为内存结构
+func fromStoreHighlights(highlights []store.Highlight) []Highlight {
+	out := make([]Highlight, len(highlights))
+	for i, h := range highlights {
+		out[i] = Highlight{
+			ID:           h.ID,
+			ChapterIndex: h.ChapterIndex,
+			StartOffset:  h.StartOffset,
+			EndOffset:    h.EndOffset,
+			Color:        h.Color,
+			Note:         h.Note,
+			CreatedAt:    h.CreatedAt,
+			UpdatedAt:    h.UpdatedAt,
+		}
+	}
+	return out
+}
+
+// toStoreSettings 把内存中的阅读器设置转换为持久化记录
+func toStoreSettings(settings *BookerSettings) *store.Settings {
+	return &store.Settings{
+		ReadStyle:         int(settings.readStyle),
+		AutoScrollEnabled: settings.autoScrollEnabled,
+		MakeBookMark:      settings.makeBookMark,
+		ShowStatusBar:     settings.showStatusBar,
+		RecentPageCount:   settings.recentPageCount,
+		ScrollSpeed:       settings.scrollSpeed,
+		PageMargin:        settings.pageMargin,
+	}
+}
+
+// fromStoreSettings 把持久化的阅读器设置还原为内存结构
+func fromStoreSettings(settings *store.Settings) BookerSettings {
+	return BookerSettings{
+		readStyle:         ScrollType(settings.ReadStyle),
+		autoScrollEnabled: settings.AutoScrollEnabled,
+		makeBookMark:      settings.MakeBookMark,
+		showStatusBar:     settings.ShowStatusBar,
+		recentPageCount:   settings.RecentPageCount,
+		scrollSpeed:       settings.ScrollSpeed,
+		pageMargin:        settings.PageMargin,
+	}
+}
+
+// setStatus 设置阅读器状态；status 的读写均在锁内完成，因为自动滚动的后台 goroutine 会并发读取
 func (b *Booker) setStatus(status ReaderStatus) {
+	b.mu.Lock()
 	if b.status == status {
+		b.mu.Unlock()
 		return
 	}
-
 	b.status = status
+	autoScroll := status == StatusReading && b.autoScrollEnabled
+	b.mu.Unlock()
+
+	if autoScroll {
+		b.StartAutoScroll()
+	}
+
 	if b.statusCallback != nil {
 		b.statusCallback(status, nil)
 	}
 }
 
+// readStatus 加锁读取当前状态，供可能被自动滚动后台 goroutine 并发调用的代码路径使用
+func (b *Booker) readStatus() ReaderStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.status
+}
+
+// readStatusLocked 是 readStatus 的无锁版本，供已持有 b.mu 的调用方使用
+func (b *Booker) readStatusLocked() ReaderStatus {
+	return b.status
+}
+
 // GetStatus 获取当前状态
 func (b *Booker) GetStatus() ReaderStatus {
-	return b.status
+	return b.readStatus()
 }
 
 // GetSettings 获取当前设置
@@ -260,23 +910,29 @@ func (b *Booker) GetSettings() *BookerSettings {
 
 // UpdateScroll 更新滚动位置
 func (b *Booker) UpdateScroll(offset float32) {
-	if b.status != StatusReading {
+	if b.readStatus() != StatusReading {
 		return
 	}
 
+	if meta := b.GetCurrentBook(); meta != nil {
+		b.mu.Lock()
+		meta.LastRead = time.Now()
+		b.mu.Unlock()
+		b.persistBookDataThrottled(meta)
+	}
+
 	if b.scrollChangeCallback != nil {
 		b.scrollChangeCallback(offset)
 	}
 }
 
-// LoadBookmarks 加载书签
+// LoadBookmarks 加载书签与高亮
 func (b *Booker) LoadBookmarks() {
-	if b.status != StatusReading {
+	if b.readStatus() != StatusReading {
 		return
 	}
 
-	// TODO: 从存储中加载书签
-	bookmarks := []BookMark{}
+	bookmarks := b.loadAnnotations(b.GetCurrentBook())
 
 	if b.bookmarkLoadCallback != nil {
 		b.bookmarkLoadCallback(bookmarks)
@@ -291,6 +947,7 @@ func (b *Booker) AddToQueue(filePath string) error {
 	}
 
 	b.queue = append(b.queue, *meta)
+	b.persistQueue()
 
 	if b.fileAddCallback != nil {
 		b.fileAddCallback(meta)
@@ -401,6 +1058,26 @@ func (b *Booker) LoadSampleBooks() {
 	}
 }
 
+// Query 按 filter 筛选队列中的书籍并排序，返回匹配的元数据副本
+func (b *Booker) Query(filter BookFilter) []BookMeta {
+	var result []BookMeta
+	for i := range b.queue {
+		if filter.matches(&b.queue[i]) {
+			result = append(result, b.queue[i])
+		}
+	}
+
+	switch filter.SortBy {
+	case SortByProgress:
+		sort.Slice(result, func(i, j int) bool { return result[i].Progress > result[j].Progress })
+	case SortByName:
+		sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	default:
+		sort.Slice(result, func(i, j int) bool { return result[i].LastRead.After(result[j].LastRead) })
+	}
+	return result
+}
+
 func (b *Booker) GetRecentBooks() []BookMeta {
 	sort.Slice(b.queue, func(i, j int) bool {
 		return b.queue[i].LastRead.UnixMilli() > b.queue[j].LastRead.UnixMilli()
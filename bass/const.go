@@ -24,6 +24,14 @@ var (
 	ConverW, ConverH = float32(130), float32(210)
 )
 
+// 分页排版参数
+const (
+	DefaultFontSize   float32 = 18  // 默认字号
+	DefaultLineHeight float32 = 28  // 默认行高
+	PageWidth         float32 = 480 // 默认页面宽度
+	PageHeight        float32 = 760 // 默认页面高度
+)
+
 // ReaderStatus 阅读器状态
 type ReaderStatus int
 
@@ -40,3 +48,25 @@ const (
 	ScrollTypeVertical   ScrollType = iota // 垂直滚动
 	ScrollTypeHorizontal                   // 水平滚动
 )
+
+// PageTurnMode 翻页动画模式
+type PageTurnMode int
+
+const (
+	PageTurnNone   PageTurnMode = iota // 无动画，直接切换
+	PageTurnSlide                      // 整页横向滑动
+	PageTurnCover                      // 新页覆盖滑入，旧页保持不动
+	PageTurnScroll                     // 连续滚动，不做逐页过渡
+	PageTurnCurl                       // 卷页翻转
+)
+
+// ReadStatus 书籍的阅读状态
+type ReadStatus int
+
+const (
+	Unread    ReadStatus = iota // 未读
+	Reading                     // 在读
+	Finished                    // 已读完
+	OnHold                      // 搁置
+	Abandoned                   // 已弃读
+)
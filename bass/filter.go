@@ -0,0 +1,51 @@
+package bass
+
+import "strings"
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/18
+ * Time: 19:40
+ * Description: 书架查询与筛选条件
+ */
+
+// SortKey 书架排序字段
+type SortKey int
+
+const (
+	SortByLastRead SortKey = iota // 按最近阅读时间排序（默认）
+	SortByProgress                // 按阅读进度排序
+	SortByName                    // 按书名排序
+)
+
+// BookFilter 书架查询条件，供 Booker.Query 使用
+type BookFilter struct {
+	Keyword  string       // 对 Name/Group 做不区分大小写的子串匹配，空字符串表示不筛选
+	Statuses []ReadStatus // 状态集合，为空表示不筛选状态
+	SortBy   SortKey      // 排序字段
+}
+
+// matches 判断 meta 是否满足筛选条件
+func (f BookFilter) matches(meta *BookMeta) bool {
+	if f.Keyword != "" {
+		kw := strings.ToLower(f.Keyword)
+		if !strings.Contains(strings.ToLower(meta.Name), kw) && !strings.Contains(strings.ToLower(meta.Group), kw) {
+			return false
+		}
+	}
+
+	if len(f.Statuses) > 0 {
+		matched := false
+		for _, s := range f.Statuses {
+			if meta.Status == s {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,244 @@
+package bass
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"goread/bass/store"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/23
+ * Time: 11:20
+ * Description: 书架分组管理，分组顺序与组内书籍顺序通过 GroupStore 原子持久化
+ */
+
+// BookGroup 书架分组
+type BookGroup struct {
+	ID        string // 分组唯一标识
+	Name      string // 分组名称
+	Order     int    // 分组在书架中的排序
+	CoverPath string // 分组封面，留空时由 UI 取组内第一本书的封面兜底
+}
+
+// CreateGroup 创建一个新分组，追加在已有分组之后
+func (b *Booker) CreateGroup(name string) BookGroup {
+	group := BookGroup{
+		ID:    fmt.Sprintf("grp-%d", time.Now().UnixNano()),
+		Name:  name,
+		Order: len(b.groups),
+	}
+	b.groups = append(b.groups, group)
+	b.persistGroups()
+	b.notifyGroupChange()
+	return group
+}
+
+// RenameGroup 重命名分组；书籍与分组的归属关系按 BookGroup.Name 关联（见 MoveBookToGroup、
+// GetBooksByGroup），因此需要把队列中原先归属该分组的书籍一并改写为新名称，否则它们会失去归属
+func (b *Booker) RenameGroup(id, name string) {
+	var oldName string
+	for i := range b.groups {
+		if b.groups[i].ID == id {
+			oldName = b.groups[i].Name
+			b.groups[i].Name = name
+			break
+		}
+	}
+	if oldName == "" {
+		return
+	}
+
+	for i := range b.queue {
+		if b.queue[i].Group == oldName {
+			b.queue[i].Group = name
+		}
+	}
+
+	b.persistGroups()
+	b.notifyGroupChange()
+}
+
+// DeleteGroup 删除分组，组内书籍归入未分组（Group 清空）
+func (b *Booker) DeleteGroup(id string) {
+	var name string
+	for i, g := range b.groups {
+		if g.ID == id {
+			name = g.Name
+			b.groups = append(b.groups[:i], b.groups[i+1:]...)
+			break
+		}
+	}
+
+	for i := range b.queue {
+		if b.queue[i].Group == name {
+			b.queue[i].Group = ""
+			b.queue[i].GroupPosition = 0
+		}
+	}
+
+	b.persistGroups()
+	b.notifyGroupChange()
+}
+
+// MoveBookToGroup 把 bookID（书籍文件路径）指向的书籍移动到 group 分组的 position 位置
+func (b *Booker) MoveBookToGroup(bookID string, group string, position int) {
+	meta := b.findBook(bookID)
+	if meta == nil {
+		return
+	}
+	meta.Group = group
+	meta.GroupPosition = position
+	b.persistGroups()
+	b.notifyGroupChange()
+}
+
+// SaveGroupOrder 按 orderedIDs 给出的顺序重排分组列表，供拖拽排序后整体提交；
+// orderedIDs 未覆盖到的分组（例如排序提交前新建的分组）会保留在末尾，而不是被丢弃
+func (b *Booker) SaveGroupOrder(orderedIDs []string) {
+	byID := make(map[string]BookGroup, len(b.groups))
+	for _, g := range b.groups {
+		byID[g.ID] = g
+	}
+
+	seen := make(map[string]bool, len(orderedIDs))
+	reordered := make([]BookGroup, 0, len(b.groups))
+	for i, id := range orderedIDs {
+		if g, ok := byID[id]; ok {
+			g.Order = i
+			reordered = append(reordered, g)
+			seen[id] = true
+		}
+	}
+	for _, g := range b.groups {
+		if !seen[g.ID] {
+			g.Order = len(reordered)
+			reordered = append(reordered, g)
+		}
+	}
+	b.groups = reordered
+
+	b.persistGroups()
+	b.notifyGroupChange()
+}
+
+// GetBooksByGroup 返回指定分组内的书籍，按 GroupPosition 排序
+func (b *Booker) GetBooksByGroup(group string) []BookMeta {
+	var result []BookMeta
+	for _, meta := range b.queue {
+		if meta.Group == group {
+			result = append(result, meta)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GroupPosition < result[j].GroupPosition })
+	return result
+}
+
+// GetGroups 返回当前分组列表，按 Order 排序
+func (b *Booker) GetGroups() []BookGroup {
+	result := make([]BookGroup, len(b.groups))
+	copy(result, b.groups)
+	sort.Slice(result, func(i, j int) bool { return result[i].Order < result[j].Order })
+	return result
+}
+
+// SetGroupStore 设置分组的持久化实现，未设置时分组只保存在内存中
+func (b *Booker) SetGroupStore(s store.GroupStore) {
+	b.groupStore = s
+}
+
+// LoadGroups 从 GroupStore 读取分组列表与组内书籍顺序，并把书籍顺序写回队列中对应书籍的 GroupPosition
+func (b *Booker) LoadGroups() {
+	if b.groupStore == nil {
+		return
+	}
+
+	data, err := b.groupStore.LoadGroups()
+	if err != nil {
+		return
+	}
+
+	b.groups = fromStoreGroups(data.Groups)
+	for group, ids := range data.BookPositions {
+		for pos, id := range ids {
+			if meta := b.findBook(id); meta != nil {
+				meta.Group = group
+				meta.GroupPosition = pos
+			}
+		}
+	}
+
+	b.notifyGroupChange()
+}
+
+// findBook 按 FilePath 在队列中查找书籍
+func (b *Booker) findBook(filePath string) *BookMeta {
+	for i := range b.queue {
+		if b.queue[i].FilePath == filePath {
+			return &b.queue[i]
+		}
+	}
+	return nil
+}
+
+// persistGroups 把当前分组列表与组内书籍顺序写入 GroupStore，未配置时忽略
+func (b *Booker) persistGroups() {
+	if b.groupStore == nil {
+		return
+	}
+
+	data := &store.GroupData{
+		Groups:        toStoreGroups(b.groups),
+		BookPositions: b.bookPositionsByGroup(),
+	}
+	_ = b.groupStore.SaveGroups(data)
+}
+
+// bookPositionsByGroup 按 GroupPosition 排序，导出每个分组内的书籍 ID 顺序
+func (b *Booker) bookPositionsByGroup() map[string][]string {
+	byGroup := make(map[string][]BookMeta)
+	for _, m := range b.queue {
+		if m.Group == "" {
+			continue
+		}
+		byGroup[m.Group] = append(byGroup[m.Group], m)
+	}
+
+	positions := make(map[string][]string, len(byGroup))
+	for name, metas := range byGroup {
+		sort.Slice(metas, func(i, j int) bool { return metas[i].GroupPosition < metas[j].GroupPosition })
+		ids := make([]string, len(metas))
+		for i, m := range metas {
+			ids[i] = m.FilePath
+		}
+		positions[name] = ids
+	}
+	return positions
+}
+
+// notifyGroupChange 触发分组变更回调
+func (b *Booker) notifyGroupChange() {
+	if b.groupChangeCallback != nil {
+		b.groupChangeCallback(b.groups)
+	}
+}
+
+// toStoreGroups 把内存中的分组转换为持久化记录
+func toStoreGroups(groups []BookGroup) []store.GroupRecord {
+	out := make([]store.GroupRecord, len(groups))
+	for i, g := range groups {
+		out[i] = store.GroupRecord{ID: g.ID, Name: g.Name, Order: g.Order, CoverPath: g.CoverPath}
+	}
+	return out
+}
+
+// fromStoreGroups 把持久化的分组记录还原为内存结构
+func fromStoreGroups(groups []store.GroupRecord) []BookGroup {
+	out := make([]BookGroup, len(groups))
+	for i, g := range groups {
+		out[i] = BookGroup{ID: g.ID, Name: g.Name, Order: g.Order, CoverPath: g.CoverPath}
+	}
+	return out
+}
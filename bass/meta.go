@@ -1,6 +1,9 @@
 package bass
 
-import "time"
+import (
+	"image/color"
+	"time"
+)
 
 /**
  * @author: zhashut
@@ -16,18 +19,32 @@ type BookMark struct {
 	CreatedAt time.Time // 创建时间
 }
 
+// Highlight 高亮片段，记录章节内的字符偏移区间、颜色与可选笔记
+type Highlight struct {
+	ID           string      // 高亮唯一标识
+	ChapterIndex int         // 所在章节索引，与 parser.Book.Chapters 的下标对应
+	StartOffset  int         // 起始字符偏移（含）
+	EndOffset    int         // 结束字符偏移（不含）
+	Color        color.NRGBA // 高亮颜色
+	Note         string      // 附加笔记，可为空
+	CreatedAt    time.Time   // 创建时间
+	UpdatedAt    time.Time   // 最后修改时间
+}
+
 // BookMeta 书籍元数据
 type BookMeta struct {
-	FilePath      string     // 文件路径
-	Name          string     // 书名
-	Group         string     // 所属分组
-	Progress      float32    // 阅读进度（百分比）
-	TotalPage     int        // 总页码
-	CurrentPage   int        //  当前页码
-	LastPage      int        // 上次阅读页码
-	LastRead      time.Time  // 上次阅读时间
-	Bookmarks     []BookMark // 书签列表
-	GroupPosition int        //  分组中的排序
-	ScaleFactor   float32    // 缩放比列
-	CoverPath     string     // 封面图片路径
+	FilePath      string      // 文件路径
+	Name          string      // 书名
+	Group         string      // 所属分组
+	Progress      float32     // 阅读进度（百分比）
+	TotalPage     int         // 总页码
+	CurrentPage   int         //  当前页码
+	LastPage      int         // 上次阅读页码
+	LastRead      time.Time   // 上次阅读时间
+	Status        ReadStatus  // 阅读状态
+	Bookmarks     []BookMark  // 书签列表
+	Highlights    []Highlight // 高亮与笔记列表
+	GroupPosition int         //  分组中的排序
+	ScaleFactor   float32     // 缩放比列
+	CoverPath     string      // 封面图片路径
 }
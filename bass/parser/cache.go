@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:24
+ * Description: 解析结果的磁盘缓存，按文件内容哈希命中，避免重复解析和分页
+ */
+
+// cacheDir 缓存文件存放目录
+const cacheDir = ".goread/cache"
+
+// hashFile 计算文件内容的 sha256，用作缓存键
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// cachePath 返回指定哈希对应的缓存文件路径
+func cachePath(hash string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, cacheDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, hash+".json"), nil
+}
+
+// loadCached 尝试从磁盘缓存加载已解析并分页的 Book
+func loadCached(hash string) (*Book, bool) {
+	path, err := cachePath(hash)
+	if err != nil {
+		return nil, false
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var book Book
+	if err := json.Unmarshal(raw, &book); err != nil {
+		return nil, false
+	}
+	return &book, true
+}
+
+// saveCached 将解析并分页后的 Book 写入磁盘缓存
+func saveCached(hash string, book *Book) error {
+	path, err := cachePath(hash)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(book)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
+
+// ParseAndPaginate 解析 path 指向的书籍并按 layout 分页，命中磁盘缓存时直接返回
+func ParseAndPaginate(path string, layout PageLayout) (*Book, error) {
+	hash, err := hashFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := loadCached(hash); ok {
+		return cached, nil
+	}
+
+	p, err := ForPath(path)
+	if err != nil {
+		return nil, err
+	}
+	book, err := p.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	Paginate(book, layout)
+
+	// 缓存写入失败不影响本次打开，下次重新解析即可
+	_ = saveCached(hash, book)
+	return book, nil
+}
@@ -0,0 +1,204 @@
+package parser
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:16
+ * Description: EPUB 解析器，解压容器后按 OPF spine 顺序读取 XHTML 章节
+ */
+
+// EpubParser EPUB 电子书解析器
+type EpubParser struct{}
+
+// NewEpubParser 创建 EPUB 解析器
+func NewEpubParser() *EpubParser {
+	return &EpubParser{}
+}
+
+// container META-INF/container.xml 的结构，用于定位 OPF 文件
+type container struct {
+	Rootfiles struct {
+		Rootfile []struct {
+			FullPath string `xml:"full-path,attr"`
+		} `xml:"rootfile"`
+	} `xml:"rootfiles"`
+}
+
+// opfPackage OPF 文件中 manifest/spine/metadata 的结构
+type opfPackage struct {
+	Metadata struct {
+		Meta []struct {
+			Name    string `xml:"name,attr"`
+			Content string `xml:"content,attr"`
+		} `xml:"meta"`
+	} `xml:"metadata"`
+	Manifest struct {
+		Items []struct {
+			ID        string `xml:"id,attr"`
+			Href      string `xml:"href,attr"`
+			MediaType string `xml:"media-type,attr"`
+		} `xml:"item"`
+	} `xml:"manifest"`
+	Spine struct {
+		ItemRefs []struct {
+			IDRef string `xml:"idref,attr"`
+		} `xml:"itemref"`
+	} `xml:"spine"`
+}
+
+// Parse 解压 EPUB 容器，按 container.xml -> OPF -> manifest/spine 的顺序读取章节
+func (p *EpubParser) Parse(filePath string) (*Book, error) {
+	zr, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var c container
+	if err := readXML(files, "META-INF/container.xml", &c); err != nil {
+		return nil, err
+	}
+	if len(c.Rootfiles.Rootfile) == 0 {
+		return nil, fmt.Errorf("parser: epub 缺少 rootfile 声明")
+	}
+	opfPath := c.Rootfiles.Rootfile[0].FullPath
+	opfDir := path.Dir(opfPath)
+
+	var pkg opfPackage
+	if err := readXML(files, opfPath, &pkg); err != nil {
+		return nil, err
+	}
+
+	hrefByID := make(map[string]string, len(pkg.Manifest.Items))
+	for _, item := range pkg.Manifest.Items {
+		hrefByID[item.ID] = item.Href
+	}
+
+	book := &Book{Title: titleFromPath(filePath)}
+
+	// 按 spine 声明的顺序依次加载 XHTML 章节
+	for _, ref := range pkg.Spine.ItemRefs {
+		href, ok := hrefByID[ref.IDRef]
+		if !ok {
+			continue
+		}
+		chapterPath := path.Join(opfDir, href)
+		raw, err := readFile(files, chapterPath)
+		if err != nil {
+			continue // 容错：单个缺失的章节文件不应让整本书解析失败
+		}
+		book.Chapters = append(book.Chapters, Chapter{
+			Title:   titleFromHref(href),
+			Content: stripTags(string(raw)),
+		})
+	}
+
+	// 封面：metadata 里 name="cover" 的 meta 指向 manifest 中的图片 id；图片本身在 zip 包内，
+	// 需要解出到磁盘缓存目录，CoverPath 才是 UI 可直接按文件路径加载的封面
+	for _, meta := range pkg.Metadata.Meta {
+		if meta.Name != "cover" {
+			continue
+		}
+		href, ok := hrefByID[meta.Content]
+		if !ok {
+			continue
+		}
+		if coverPath, err := extractCoverToCache(files, path.Join(opfDir, href)); err == nil {
+			book.CoverPath = coverPath
+		}
+	}
+
+	return book, nil
+}
+
+// extractCoverToCache 把 zip 包内 coverPath 对应的封面图片解到磁盘缓存目录（与 cache.go 的
+// 分页结果缓存同目录），按内容哈希命名以便重复书籍共用同一份文件，返回可直接被 UI 加载的磁盘路径
+func extractCoverToCache(files map[string]*zip.File, coverPath string) (string, error) {
+	raw, err := readFile(files, coverPath)
+	if err != nil {
+		return "", err
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, cacheDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+	dest := filepath.Join(dir, hex.EncodeToString(sum[:])+path.Ext(coverPath))
+	if _, err := os.Stat(dest); err == nil {
+		return dest, nil // 已缓存过，避免重复写入
+	}
+	if err := os.WriteFile(dest, raw, 0o644); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// readXML 从压缩包中按路径读取并解析一个 XML 文件
+func readXML(files map[string]*zip.File, name string, v interface{}) error {
+	raw, err := readFile(files, name)
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(raw, v)
+}
+
+// readFile 从压缩包中按路径读取一个文件的全部内容
+func readFile(files map[string]*zip.File, name string) ([]byte, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("parser: epub 缺少文件 %q", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// titleFromHref 从章节文件名推断一个兜底标题
+func titleFromHref(href string) string {
+	base := path.Base(href)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+var tagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+// stripTags 粗略去除 XHTML 标签，保留纯文本正文
+func stripTags(xhtml string) string {
+	text := tagPattern.ReplaceAllString(xhtml, "\n")
+	lines := strings.Split(text, "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/29
+ * Time: 14:20
+ * Description: 按缩放比例重新分页、渲染单页的格式后端，建在 Parser 之上
+ */
+
+// 未通过 Open 指定版式时使用的默认字号/行高/页面尺寸，与 bass 包的默认版式常量取值一致，
+// 但 parser 包不能反过来依赖 bass（bass 已经依赖 parser），因此在这里各自保留一份
+const (
+	defaultRenderFontSize   float32 = 18
+	defaultRenderLineHeight float32 = 28
+	defaultRenderPageWidth  float32 = 480
+	defaultRenderPageHeight float32 = 760
+)
+
+// TOCEntry 目录中的一个条目，Chapter 对应 Book.Chapters 及 RenderPage 分页时的章节下标
+type TOCEntry struct {
+	Title   string // 章节标题
+	Chapter int    // 对应 Book.Chapters 的下标
+}
+
+// TOC 书籍目录，按原书章节顺序排列
+type TOC struct {
+	Entries []TOCEntry
+}
+
+// RenderOptions 渲染单页所需的选项
+type RenderOptions struct {
+	Scale float32 // 缩放比例，<= 0 时按 1.0 处理；用于按 BookMeta.ScaleFactor 重新分页
+}
+
+// Page 渲染后的单页内容
+type Page struct {
+	Number int    // 页码，从 1 开始
+	Text   string // 本页正文
+}
+
+// BookDocument 由 BookFormat.Open 打开后返回的文档句柄，持有已解析的正文，
+// 可重复按不同缩放比例重新分页、渲染任意一页，而不必重新打开文件
+type BookDocument interface {
+	// Parse 返回目录与章节列表；文档在 Open 时已经解析完成，这里不做重复解析
+	Parse(ctx context.Context) (TOC, []Chapter, error)
+	// RenderPage 按 opts.Scale 重新分页后返回第 page 页（从 1 开始）的正文
+	RenderPage(page int, opts RenderOptions) (Page, error)
+	// CountPages 按 scale 重新分页后返回总页数
+	CountPages(scale float32) int
+}
+
+// BookFormat 格式后端，Open 打开 path 指向的文件并解析正文，返回可按缩放比例重新分页的文档句柄
+type BookFormat interface {
+	Open(path string) (BookDocument, error)
+}
+
+// formats 按扩展名注册的格式后端
+var formats = map[string]BookFormat{}
+
+// RegisterFormat 注册一个扩展名对应的格式后端，扩展名需包含前导点，如 ".txt"
+func RegisterFormat(ext string, f BookFormat) {
+	formats[strings.ToLower(ext)] = f
+}
+
+// FormatForPath 根据文件扩展名返回对应的格式后端
+func FormatForPath(path string) (BookFormat, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	f, ok := formats[ext]
+	if !ok {
+		return nil, fmt.Errorf("parser: 不支持的文件格式 %q", ext)
+	}
+	return f, nil
+}
+
+// flatTextFormat 把只产出扁平正文的 Parser 适配成 BookFormat：EPUB/PDF/TXT/MOBI 目前都只解析
+// 出不带版式信息的章节正文，没有各自独立的分页/渲染能力，因此复用同一套适配逻辑——按请求的
+// scale 重新计算版式再调用 Paginate，而不是每种格式各自实现一遍重新分页
+type flatTextFormat struct {
+	parser Parser
+}
+
+// Open 解析 path 指向的文件一次，返回的文档句柄之后可反复按不同缩放比例重新分页
+func (f flatTextFormat) Open(path string) (BookDocument, error) {
+	book, err := f.parser.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	return &flatTextDocument{book: book}, nil
+}
+
+// flatTextDocument 缓存最近一次重新分页所用的缩放比例，避免 RenderPage/CountPages 被连续
+// 调用（如逐页渲染同一缩放下的多页）时重复触发整本书的重新分页
+type flatTextDocument struct {
+	book *Book
+
+	paginatedScale float32
+	paginated      bool
+}
+
+// Parse 返回目录与章节列表；文档在 Open 时已经解析完成，这里不做重复解析
+func (d *flatTextDocument) Parse(_ context.Context) (TOC, []Chapter, error) {
+	toc := TOC{Entries: make([]TOCEntry, len(d.book.Chapters))}
+	for i, ch := range d.book.Chapters {
+		toc.Entries[i] = TOCEntry{Title: ch.Title, Chapter: i}
+	}
+	return toc, d.book.Chapters, nil
+}
+
+// repaginate 按 scale 缩放默认版式并重新分页，scale 与上次相同时跳过，避免重复计算
+func (d *flatTextDocument) repaginate(scale float32) {
+	if scale <= 0 {
+		scale = 1.0
+	}
+	if d.paginated && d.paginatedScale == scale {
+		return
+	}
+	RepaginateForScale(d.book, scale)
+	d.paginatedScale = scale
+	d.paginated = true
+}
+
+// CountPages 按 scale 重新分页后返回总页数
+func (d *flatTextDocument) CountPages(scale float32) int {
+	d.repaginate(scale)
+	return d.book.TotalPage()
+}
+
+// RenderPage 按 opts.Scale 重新分页后返回第 page 页的正文，page 越界时返回错误
+func (d *flatTextDocument) RenderPage(page int, opts RenderOptions) (Page, error) {
+	d.repaginate(opts.Scale)
+	if _, _, _, ok := d.book.PageRange(page); !ok {
+		return Page{}, fmt.Errorf("parser: 页码 %d 超出范围", page)
+	}
+	return Page{Number: page, Text: d.book.PageText(page)}, nil
+}
+
+func init() {
+	RegisterFormat(".txt", flatTextFormat{parser: NewTxtParser(DefaultChapterPatterns)})
+	RegisterFormat(".epub", flatTextFormat{parser: NewEpubParser()})
+	RegisterFormat(".pdf", flatTextFormat{parser: NewPdfParser()})
+	RegisterFormat(".mobi", flatTextFormat{parser: NewMobiParser()})
+}
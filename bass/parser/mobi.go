@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/19
+ * Time: 10:40
+ * Description: MOBI 解析器，读取 PalmDB 记录表，解压 PalmDOC 正文后按 HTML 内容解析
+ */
+
+// MobiParser MOBI 电子书解析器
+type MobiParser struct{}
+
+// NewMobiParser 创建 MOBI 解析器
+func NewMobiParser() *MobiParser {
+	return &MobiParser{}
+}
+
+const palmDBHeaderSize = 78
+
+// Parse 读取 PalmDB 记录表，拼接并解压 PalmDOC 正文记录，再去除 HTML 标签后按章节标题正则切分
+func (p *MobiParser) Parse(path string) (*Book, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) < palmDBHeaderSize+2 {
+		return nil, fmt.Errorf("parser: mobi 文件过短")
+	}
+
+	numRecords := int(binary.BigEndian.Uint16(raw[76:78]))
+	if numRecords == 0 {
+		return nil, fmt.Errorf("parser: mobi 缺少记录")
+	}
+	if palmDBHeaderSize+numRecords*8 > len(raw) {
+		return nil, fmt.Errorf("parser: mobi 记录表已截断")
+	}
+
+	offsets := make([]uint32, numRecords)
+	for i := 0; i < numRecords; i++ {
+		entry := raw[palmDBHeaderSize+i*8:]
+		offsets[i] = binary.BigEndian.Uint32(entry[:4])
+	}
+	recordAt := func(i int) []byte {
+		start := offsets[i]
+		end := uint32(len(raw))
+		if i+1 < numRecords {
+			end = offsets[i+1]
+		}
+		if start > end || end > uint32(len(raw)) {
+			return nil
+		}
+		return raw[start:end]
+	}
+
+	header := recordAt(0)
+	if len(header) < 16 {
+		return nil, fmt.Errorf("parser: mobi 缺少 PalmDOC 头")
+	}
+	compression := binary.BigEndian.Uint16(header[0:2])
+	textRecordCount := int(binary.BigEndian.Uint16(header[8:10]))
+	if textRecordCount > numRecords-1 {
+		textRecordCount = numRecords - 1
+	}
+
+	var raw2 strings.Builder
+	for i := 1; i <= textRecordCount; i++ {
+		switch compression {
+		case 1:
+			raw2.Write(recordAt(i))
+		case 2:
+			raw2.WriteString(decompressPalmDOC(recordAt(i)))
+		default:
+			return nil, fmt.Errorf("parser: 不支持的 mobi 压缩方式 %d", compression)
+		}
+	}
+
+	content := stripTags(raw2.String())
+	chapters := splitByPatterns(content, DefaultChapterPatterns)
+	if len(chapters) == 0 {
+		chapters = []Chapter{{Title: titleFromPath(path), Content: strings.TrimSpace(content)}}
+	}
+
+	return &Book{
+		Title:    titleFromPath(path),
+		Chapters: chapters,
+	}, nil
+}
+
+// decompressPalmDOC 还原一条 PalmDOC（LZ77 变体）压缩的文本记录
+func decompressPalmDOC(data []byte) string {
+	var out []byte
+	for i := 0; i < len(data); {
+		b := data[i]
+		switch {
+		case b == 0:
+			out = append(out, b)
+			i++
+		case b <= 8:
+			n := int(b)
+			i++
+			if i+n > len(data) {
+				n = len(data) - i
+			}
+			out = append(out, data[i:i+n]...)
+			i += n
+		case b <= 0x7F:
+			out = append(out, b)
+			i++
+		case b <= 0xBF:
+			if i+1 >= len(data) {
+				i = len(data)
+				break
+			}
+			word := int(b)<<8 | int(data[i+1])
+			i += 2
+			distance := (word >> 3) & 0x7FF
+			length := (word & 0x7) + 3
+			if distance == 0 || distance > len(out) {
+				continue
+			}
+			start := len(out) - distance
+			for j := 0; j < length; j++ {
+				out = append(out, out[start+j])
+			}
+		default: // 0xC0-0xFF：空格 + (b ^ 0x80) 对应的字符
+			out = append(out, ' ', b^0x80)
+			i++
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,74 @@
+package parser
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:20
+ * Description: 分页逻辑，将章节正文按版式切分为若干页
+ */
+
+// PageLayout 分页所需的版式参数，均来自 bass 包的常量
+type PageLayout struct {
+	FontSize   float32 // 字号
+	LineHeight float32 // 行高
+	PageWidth  float32 // 页面宽度
+	PageHeight float32 // 页面高度
+}
+
+// charsPerPage 粗略估算一页能容纳的字符数：按中文字符近似正方形估算单字宽度
+func (l PageLayout) charsPerPage() int {
+	if l.FontSize <= 0 || l.LineHeight <= 0 || l.PageWidth <= 0 || l.PageHeight <= 0 {
+		return 0
+	}
+	charsPerLine := int(l.PageWidth / l.FontSize)
+	linesPerPage := int(l.PageHeight / l.LineHeight)
+	if charsPerLine <= 0 {
+		charsPerLine = 1
+	}
+	if linesPerPage <= 0 {
+		linesPerPage = 1
+	}
+	return charsPerLine * linesPerPage
+}
+
+// RepaginateForScale 按 scale 缩放默认字号与行高后重新分页，供已解析、已缓存在内存中的 Book
+// 按新的缩放比例重新计算页数与分页偏移，而不必重新解析文件；scale <= 0 时按 1.0 处理
+func RepaginateForScale(book *Book, scale float32) {
+	if scale <= 0 {
+		scale = 1.0
+	}
+	Paginate(book, scaledLayout(scale))
+}
+
+// scaledLayout 按 scale 缩放字号与行高：scale 越大单页能容纳的字符越少，页数越多，
+// 页面宽高本身不随缩放改变（与实际阅读器窗口大小无关，这里只关心字号对分页的影响）；
+// 字号/行高/页面尺寸的基准值与 bass 包的默认版式常量取值一致，详见 format.go 顶部的说明
+func scaledLayout(scale float32) PageLayout {
+	return PageLayout{
+		FontSize:   defaultRenderFontSize * scale,
+		LineHeight: defaultRenderLineHeight * scale,
+		PageWidth:  defaultRenderPageWidth,
+		PageHeight: defaultRenderPageHeight,
+	}
+}
+
+// Paginate 按给定版式为 Book 的每个章节计算分页偏移量
+func Paginate(book *Book, layout PageLayout) {
+	perPage := layout.charsPerPage()
+	if perPage <= 0 {
+		perPage = 500 // 兜底值，避免版式参数缺失时页数算出 0
+	}
+
+	book.PageBreaks = make([][]int, len(book.Chapters))
+	for i, chapter := range book.Chapters {
+		runes := []rune(chapter.Content)
+		var breaks []int
+		for offset := 0; offset < len(runes); offset += perPage {
+			breaks = append(breaks, offset)
+		}
+		if len(breaks) == 0 {
+			breaks = []int{0}
+		}
+		book.PageBreaks[i] = breaks
+	}
+}
@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:10
+ * Description: 书籍解析器接口及格式注册表
+ */
+
+// Chapter 章节内容
+type Chapter struct {
+	Title   string // 章节标题
+	Content string // 章节正文
+}
+
+// Book 解析后的书籍内容
+type Book struct {
+	Title      string    // 书名
+	CoverPath  string    // 封面图片路径
+	Chapters   []Chapter // 章节列表，按原书顺序排列
+	PageBreaks [][]int   // 每章节的分页偏移量（字符偏移），下标与 Chapters 对应
+}
+
+// TotalPage 统计所有章节的总页数
+func (b *Book) TotalPage() int {
+	total := 0
+	for _, breaks := range b.PageBreaks {
+		total += len(breaks)
+	}
+	return total
+}
+
+// PageRange 返回全局页码（从 1 开始）对应的章节下标及该页在章节正文内的字符偏移区间 [start, end)
+func (b *Book) PageRange(pageNum int) (chapterIndex, start, end int, ok bool) {
+	if pageNum < 1 {
+		return 0, 0, 0, false
+	}
+
+	idx := pageNum - 1
+	for i, breaks := range b.PageBreaks {
+		if idx >= len(breaks) {
+			idx -= len(breaks)
+			continue
+		}
+		runes := []rune(b.Chapters[i].Content)
+		start = breaks[idx]
+		end = len(runes)
+		if idx+1 < len(breaks) {
+			end = breaks[idx+1]
+		}
+		return i, start, end, true
+	}
+	return 0, 0, 0, false
+}
+
+// PageText 返回全局页码（从 1 开始）对应的正文内容，页码越界时返回空字符串
+func (b *Book) PageText(pageNum int) string {
+	chapterIndex, start, end, ok := b.PageRange(pageNum)
+	if !ok {
+		return ""
+	}
+	runes := []rune(b.Chapters[chapterIndex].Content)
+	return string(runes[start:end])
+}
+
+// Parser 书籍解析器，负责把磁盘上的一个文件解析成扁平的 Book（仅正文与章节，不含版式信息）；
+// 按缩放比例重新分页、渲染单页的能力由 format.go 的 BookFormat/BookDocument 建在 Parser 之上提供
+type Parser interface {
+	// Parse 解析 path 指向的书籍文件
+	Parse(path string) (*Book, error)
+}
+
+// registry 按扩展名注册的解析器
+var registry = map[string]Parser{}
+
+// Register 注册一个扩展名对应的解析器，扩展名需包含前导点，如 ".txt"
+func Register(ext string, p Parser) {
+	registry[strings.ToLower(ext)] = p
+}
+
+// ForPath 根据文件扩展名返回对应的解析器
+func ForPath(path string) (Parser, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	p, ok := registry[ext]
+	if !ok {
+		return nil, fmt.Errorf("parser: 不支持的文件格式 %q", ext)
+	}
+	return p, nil
+}
+
+func init() {
+	Register(".txt", NewTxtParser(DefaultChapterPatterns))
+	Register(".epub", NewEpubParser())
+	Register(".pdf", NewPdfParser())
+	Register(".mobi", NewMobiParser())
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/19
+ * Time: 10:05
+ * Description: PDF 解析器，提取内容流中的文本算子，不做版面还原
+ */
+
+// PdfParser PDF 解析器
+type PdfParser struct{}
+
+// NewPdfParser 创建 PDF 解析器
+func NewPdfParser() *PdfParser {
+	return &PdfParser{}
+}
+
+var streamPattern = regexp.MustCompile(`(?s)stream\r?\n(.*?)\r?\nendstream`)
+
+// Parse 提取 PDF 每个内容流中的文本算子并拼接正文，再按章节标题正则切分
+func (p *PdfParser) Parse(path string) (*Book, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	for _, m := range streamPattern.FindAllSubmatch(raw, -1) {
+		text.WriteString(extractStreamText(m[1]))
+		text.WriteByte('\n')
+	}
+
+	content := text.String()
+	chapters := splitByPatterns(content, DefaultChapterPatterns)
+	if len(chapters) == 0 {
+		chapters = []Chapter{{Title: titleFromPath(path), Content: strings.TrimSpace(content)}}
+	}
+
+	return &Book{
+		Title:    titleFromPath(path),
+		Chapters: chapters,
+	}, nil
+}
+
+// extractStreamText 尝试对内容流做 FlateDecode，失败则按原始字节处理，再提取 Tj/TJ 算子中的文本
+func extractStreamText(stream []byte) string {
+	if zr, err := zlib.NewReader(bytes.NewReader(stream)); err == nil {
+		if decoded, err := io.ReadAll(zr); err == nil {
+			stream = decoded
+		}
+		zr.Close()
+	}
+	return textOperatorPattern.ReplaceAllStringFunc(string(stream), func(op string) string {
+		return decodeTextOperand(op) + "\n"
+	})
+}
+
+// textOperatorPattern 匹配 "(...)Tj" 与 "[(...)...]TJ" 两种文本绘制算子
+var textOperatorPattern = regexp.MustCompile(`\(((?:[^()\\]|\\.)*)\)\s*Tj|\[((?:[^\[\]\\]|\\.)*)\]\s*TJ`)
+
+// decodeTextOperand 从一个 Tj/TJ 算子中取出括号内的字面量文本，去掉 PDF 字符串转义
+func decodeTextOperand(op string) string {
+	parens := regexp.MustCompile(`\(((?:[^()\\]|\\.)*)\)`)
+	var out strings.Builder
+	for _, m := range parens.FindAllStringSubmatch(op, -1) {
+		out.WriteString(unescapePDFString(m[1]))
+	}
+	return out.String()
+}
+
+var pdfEscapePattern = regexp.MustCompile(`\\[nrtbf()\\]`)
+
+// unescapePDFString 还原 PDF 字符串字面量里的反斜杠转义
+func unescapePDFString(s string) string {
+	return pdfEscapePattern.ReplaceAllStringFunc(s, func(esc string) string {
+		switch esc[1] {
+		case 'n':
+			return "\n"
+		case 'r':
+			return "\r"
+		case 't':
+			return "\t"
+		case '(', ')', '\\':
+			return esc[1:]
+		default:
+			return ""
+		}
+	})
+}
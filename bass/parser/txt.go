@@ -0,0 +1,48 @@
+package parser
+
+import (
+	"os"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:12
+ * Description: TXT 文本解析器，按正则匹配章节标题切分内容
+ */
+
+// DefaultChapterPatterns 默认的章节标题匹配规则，依次尝试，命中第一个即采用
+var DefaultChapterPatterns = []string{
+	`^第.{1,10}[章回节卷集]`,
+	`^Chapter\s*\d+`,
+}
+
+// TxtParser TXT 文本解析器
+type TxtParser struct {
+	patterns []string // 章节标题正则，按顺序尝试
+}
+
+// NewTxtParser 创建 TXT 解析器，patterns 为章节标题的正则表达式列表
+func NewTxtParser(patterns []string) *TxtParser {
+	return &TxtParser{patterns: patterns}
+}
+
+// Parse 解析 TXT 文件，按章节标题正则切分为若干 Chapter
+func (p *TxtParser) Parse(path string) (*Book, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters := splitByPatterns(string(raw), p.patterns)
+	// 没有任何一行命中章节标题正则时，整本书作为单一章节返回
+	if len(chapters) == 0 {
+		chapters = []Chapter{{Title: titleFromPath(path), Content: strings.TrimSpace(string(raw))}}
+	}
+
+	return &Book{
+		Title:    titleFromPath(path),
+		Chapters: chapters,
+	}, nil
+}
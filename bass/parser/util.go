@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/13
+ * Time: 09:14
+ * Description: 解析器间共用的小工具
+ */
+
+// titleFromPath 取文件名（去掉扩展名）作为书名的兜底值
+func titleFromPath(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// splitByPatterns 按 patterns 匹配的章节标题行切分 content，命中标题的一行作为 Title，
+// 直到下一个命中行之前的内容作为 Content；没有任何一行命中时返回空切片
+func splitByPatterns(content string, patterns []string) []Chapter {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		compiled = append(compiled, regexp.MustCompile(p))
+	}
+
+	var chapters []Chapter
+	var title string
+	var body strings.Builder
+
+	flush := func() {
+		if title == "" && body.Len() == 0 {
+			return
+		}
+		chapters = append(chapters, Chapter{Title: title, Content: strings.TrimSpace(body.String())})
+		body.Reset()
+	}
+
+	matched := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if matchesAny(trimmed, compiled) {
+			flush()
+			title = trimmed
+			matched = true
+			continue
+		}
+		body.WriteString(line)
+		body.WriteByte('\n')
+	}
+	if !matched {
+		return nil
+	}
+	flush()
+
+	return chapters
+}
+
+// matchesAny 判断一行文本是否命中 patterns 中的任意一个
+func matchesAny(line string, patterns []*regexp.Regexp) bool {
+	if line == "" {
+		return false
+	}
+	for _, re := range patterns {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package search
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/28
+ * Time: 19:50
+ * Description: 索引的紧凑二进制持久化格式，倒排表按 docID 与偏移的增量（delta）编码为 varint
+ */
+
+// indexFileMagic 索引文件的魔数，用于快速识别格式，防止误读其他文件
+const indexFileMagic uint32 = 0x47525349 // "GRSI"
+
+// indexFileVersion 当前持久化格式版本号
+const indexFileVersion uint32 = 1
+
+// encode 把文档表与倒排表序列化为紧凑二进制格式：文档内容整段存储以支持离线摘要提取，
+// 倒排表的 docID 与偏移均按前一项做差后以 varint 写入，重复出现的小增量因此占用字节更少
+func encode(docs []docEntry, terms map[string]*postingList) []byte {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], indexFileMagic)
+	w.Write(hdr[:])
+	writeUvarint(w, uint64(indexFileVersion))
+
+	writeUvarint(w, uint64(len(docs)))
+	for _, d := range docs {
+		writeString(w, d.bookID)
+		writeUvarint(w, uint64(d.chapter))
+		writeString(w, d.content)
+		writeUvarint(w, uint64(len(d.pageBreaks)))
+		prev := 0
+		for _, p := range d.pageBreaks {
+			writeUvarint(w, uint64(p-prev))
+			prev = p
+		}
+	}
+
+	writeUvarint(w, uint64(len(terms)))
+	for term, list := range terms {
+		writeString(w, term)
+		writeUvarint(w, uint64(len(list.docIDs)))
+		prevDoc := uint32(0)
+		for i, docID := range list.docIDs {
+			writeUvarint(w, uint64(docID-prevDoc))
+			prevDoc = docID
+
+			offs := list.offsets[i]
+			writeUvarint(w, uint64(len(offs)))
+			prevOff := uint32(0)
+			for _, off := range offs {
+				writeUvarint(w, uint64(off-prevOff))
+				prevOff = off
+			}
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes()
+}
+
+// decode 从 encode 产出的字节还原文档表与倒排表
+func decode(data []byte) ([]docEntry, map[string]*postingList, error) {
+	r := bytes.NewReader(data)
+
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, nil, err
+	}
+	if binary.BigEndian.Uint32(hdr[:]) != indexFileMagic {
+		return nil, nil, fmt.Errorf("search: 索引文件魔数不匹配")
+	}
+	version, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version != uint64(indexFileVersion) {
+		return nil, nil, fmt.Errorf("search: 不支持的索引文件版本 %d", version)
+	}
+
+	numDocs, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	docs := make([]docEntry, 0, numDocs)
+	for i := uint64(0); i < numDocs; i++ {
+		bookID, err := readString(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		chapter, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		content, err := readString(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		numBreaks, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		breaks := make([]int, numBreaks)
+		prev := 0
+		for j := range breaks {
+			delta, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			prev += int(delta)
+			breaks[j] = prev
+		}
+		docs = append(docs, docEntry{bookID: bookID, chapter: int(chapter), content: content, pageBreaks: breaks})
+	}
+
+	numTerms, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	terms := make(map[string]*postingList, numTerms)
+	for i := uint64(0); i < numTerms; i++ {
+		term, err := readString(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		numPostings, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		list := &postingList{docIDs: make([]uint32, numPostings), offsets: make([][]uint32, numPostings)}
+		prevDoc := uint32(0)
+		for j := uint64(0); j < numPostings; j++ {
+			deltaDoc, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			prevDoc += uint32(deltaDoc)
+			list.docIDs[j] = prevDoc
+
+			numOffs, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			offs := make([]uint32, numOffs)
+			prevOff := uint32(0)
+			for k := range offs {
+				deltaOff, err := binary.ReadUvarint(r)
+				if err != nil {
+					return nil, nil, err
+				}
+				prevOff += uint32(deltaOff)
+				offs[k] = prevOff
+			}
+			list.offsets[j] = offs
+		}
+		terms[term] = list
+	}
+
+	return docs, terms, nil
+}
+
+// writeUvarint 写入一个无符号 varint
+func writeUvarint(w *bufio.Writer, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	w.Write(buf[:n])
+}
+
+// writeString 写入一个以 varint 长度为前缀的字符串
+func writeString(w *bufio.Writer, s string) {
+	writeUvarint(w, uint64(len(s)))
+	w.WriteString(s)
+}
+
+// readString 读取一个以 varint 长度为前缀的字符串
+func readString(r *bytes.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+// save 把索引写入 path 指向的文件，父目录不存在时自动创建
+func save(path string, docs []docEntry, terms map[string]*postingList) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, encode(docs, terms), 0o644)
+}
+
+// load 从 path 指向的文件读取索引；文件不存在时返回空的文档表与倒排表，不视为错误
+func load(path string) ([]docEntry, map[string]*postingList, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, map[string]*postingList{}, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return decode(data)
+}
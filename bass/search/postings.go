@@ -0,0 +1,85 @@
+package search
+
+import "math"
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/28
+ * Time: 19:35
+ * Description: 倒排表的内存结构及多词 AND 查询使用的跳表合并
+ */
+
+// postingList 一个词项命中的文档列表，docIDs 升序且不重复，offsets 与 docIDs 一一对应，
+// 记录该词项在对应文档内出现的全部 rune 偏移（升序）
+type postingList struct {
+	docIDs  []uint32
+	offsets [][]uint32
+}
+
+// add 把 docID 处偏移 offset 的一次命中计入倒排表；同一文档内的连续命中会合并到同一项
+func (p *postingList) add(docID uint32, offset int) {
+	if n := len(p.docIDs); n > 0 && p.docIDs[n-1] == docID {
+		p.offsets[n-1] = append(p.offsets[n-1], uint32(offset))
+		return
+	}
+	p.docIDs = append(p.docIDs, docID)
+	p.offsets = append(p.offsets, []uint32{uint32(offset)})
+}
+
+// skipStep 跳表步长，约为文档数的平方根，使顺序合并时的跳跃次数降到 O(sqrt(n))
+func skipStep(n int) int {
+	step := int(math.Sqrt(float64(n)))
+	if step < 1 {
+		step = 1
+	}
+	return step
+}
+
+// intersectDocIDs 合并两个按 docID 升序排列的倒排表，借助跳表步长跳过明显不匹配的区间，
+// 返回同时出现在两个词项中的 docID（用于多词 AND 查询）
+func intersectDocIDs(a, b []uint32) []uint32 {
+	stepA, stepB := skipStep(len(a)), skipStep(len(b))
+
+	var result []uint32
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			result = append(result, a[i])
+			i++
+			j++
+			continue
+		}
+
+		if a[i] < b[j] {
+			for i+stepA < len(a) && a[i+stepA] <= b[j] {
+				i += stepA
+			}
+			// 跳跃后若仍小于 b[j] 才前进一步；若恰好跳到相等，交给下一轮循环判等，避免漏判
+			if a[i] < b[j] {
+				i++
+			}
+			continue
+		}
+
+		for j+stepB < len(b) && b[j+stepB] <= a[i] {
+			j += stepB
+		}
+		if b[j] < a[i] {
+			j++
+		}
+	}
+	return result
+}
+
+// offsetsForDoc 返回词项在指定 docID 下记录的偏移列表，docID 未命中时返回 nil
+func (p *postingList) offsetsForDoc(docID uint32) []uint32 {
+	for i, id := range p.docIDs {
+		if id == docID {
+			return p.offsets[i]
+		}
+		if id > docID {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/28
+ * Time: 19:05
+ * Description: 书架全文检索索引，对章节正文分词建立倒排表，支持增量更新与多词 AND 查询
+ */
+
+// Chapter 参与建索的一个章节，PageBreaks 与 parser.Book.PageBreaks 对应章节保持一致，
+// 用于把命中的字符偏移换算回全局页码
+type Chapter struct {
+	Title      string // 章节标题
+	Content    string // 章节正文
+	PageBreaks []int  // 本章节各页起始的 rune 偏移，与 Content 对应
+}
+
+// SearchHit 一条检索命中
+type SearchHit struct {
+	BookID  string // 书籍文件路径，对应 BookMeta.FilePath
+	Chapter int    // 命中所在章节下标
+	Page    int    // 命中所在全局页码（从 1 开始）
+	Offset  int    // 命中在章节正文内的 rune 偏移
+	Snippet string // 命中词项前后截取的摘要，命中词项以【】标出
+}
+
+// QueryOptions 检索选项
+type QueryOptions struct {
+	Limit         int // 返回的最大命中数，<= 0 表示不限制
+	SnippetRadius int // 摘要中命中词项前后各截取的 rune 数，<= 0 时使用默认值
+}
+
+// defaultSnippetRadius 未指定 SnippetRadius 时，摘要前后各截取的 rune 数
+const defaultSnippetRadius = 20
+
+// docEntry 索引内部的一个文档单元，粒度为“一本书的一章”
+type docEntry struct {
+	bookID     string
+	chapter    int
+	content    string
+	pageBreaks []int
+}
+
+// SearchIndex 书架全文检索索引，docs 下标即为内部使用的 docID
+type SearchIndex struct {
+	mu    sync.Mutex
+	path  string
+	docs  []docEntry
+	terms map[string]*postingList
+}
+
+// NewSearchIndex 创建检索索引，若 path 指向的索引文件已存在则先加载，读取失败时返回空索引
+func NewSearchIndex(path string) *SearchIndex {
+	docs, terms, err := load(path)
+	if err != nil {
+		docs, terms = nil, map[string]*postingList{}
+	}
+	return &SearchIndex{path: path, docs: docs, terms: terms}
+}
+
+// IndexBook 把 chapters 逐章分词并计入倒排表，bookID 通常取自 BookMeta.FilePath；
+// 已建过索引的书籍需先 Remove 再重新 IndexBook，避免同一本书出现重复文档
+func (idx *SearchIndex) IndexBook(bookID string, chapters []Chapter) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for i, ch := range chapters {
+		docID := uint32(len(idx.docs))
+		idx.docs = append(idx.docs, docEntry{
+			bookID:     bookID,
+			chapter:    i,
+			content:    ch.Content,
+			pageBreaks: ch.PageBreaks,
+		})
+		for _, tok := range tokenize(ch.Content) {
+			list, ok := idx.terms[tok.term]
+			if !ok {
+				list = &postingList{}
+				idx.terms[tok.term] = list
+			}
+			list.add(docID, tok.offset)
+		}
+	}
+
+	return save(idx.path, idx.docs, idx.terms)
+}
+
+// NeedsIndex 判断 bookID 对应的 chapters 相对索引中已有的内容是否发生变化（章节数、正文或分页
+// 有任一不同，或该书尚未建过索引），供调用方在重新打开一本书时跳过没有实际变化的重建，
+// 避免每次打开都触发 Remove 的全量重新分词
+func (idx *SearchIndex) NeedsIndex(bookID string, chapters []Chapter) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	var existing []docEntry
+	for _, d := range idx.docs {
+		if d.bookID == bookID {
+			existing = append(existing, d)
+		}
+	}
+	if len(existing) != len(chapters) {
+		return true
+	}
+	for i, ch := range chapters {
+		if existing[i].content != ch.Content || !equalPageBreaks(existing[i].pageBreaks, ch.PageBreaks) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalPageBreaks 比较两组分页偏移是否完全一致
+func equalPageBreaks(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Remove 从索引中移除 bookID 对应的全部章节文档，并按剩余文档重建倒排表
+func (idx *SearchIndex) Remove(bookID string) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	hasBook := false
+	for _, d := range idx.docs {
+		if d.bookID == bookID {
+			hasBook = true
+			break
+		}
+	}
+	if !hasBook {
+		return nil
+	}
+
+	remaining := idx.docs[:0:0]
+	for _, d := range idx.docs {
+		if d.bookID != bookID {
+			remaining = append(remaining, d)
+		}
+	}
+	idx.docs = remaining
+
+	idx.terms = map[string]*postingList{}
+	for docID, d := range idx.docs {
+		for _, tok := range tokenize(d.content) {
+			list, ok := idx.terms[tok.term]
+			if !ok {
+				list = &postingList{}
+				idx.terms[tok.term] = list
+			}
+			list.add(uint32(docID), tok.offset)
+		}
+	}
+
+	return save(idx.path, idx.docs, idx.terms)
+}
+
+// Query 对 q 分词后做多词 AND 查询：只有同时包含全部词项的章节才会命中。
+// 未知词项（从未出现在索引中）会导致直接返回空结果
+func (idx *SearchIndex) Query(q string, opts QueryOptions) ([]SearchHit, error) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	terms := uniqueTerms(tokenize(q))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	lists := make([][]uint32, 0, len(terms))
+	for _, term := range terms {
+		list, ok := idx.terms[term]
+		if !ok {
+			return nil, nil
+		}
+		lists = append(lists, list.docIDs)
+	}
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+
+	matched := lists[0]
+	for _, list := range lists[1:] {
+		matched = intersectDocIDs(matched, list)
+		if len(matched) == 0 {
+			return nil, nil
+		}
+	}
+
+	radius := opts.SnippetRadius
+	if radius <= 0 {
+		radius = defaultSnippetRadius
+	}
+
+	firstTermList := idx.terms[terms[0]]
+	hits := make([]SearchHit, 0, len(matched))
+	for _, docID := range matched {
+		doc := idx.docs[docID]
+		offsets := firstTermList.offsetsForDoc(docID)
+		if len(offsets) == 0 {
+			continue
+		}
+		offset := int(offsets[0])
+
+		hits = append(hits, SearchHit{
+			BookID:  doc.bookID,
+			Chapter: doc.chapter,
+			Page:    globalPage(idx.docs, doc.bookID, doc.chapter, localPage(doc.pageBreaks, offset)),
+			Offset:  offset,
+			Snippet: snippet(doc.content, offset, len([]rune(terms[0])), radius),
+		})
+		if opts.Limit > 0 && len(hits) >= opts.Limit {
+			break
+		}
+	}
+	return hits, nil
+}
+
+// uniqueTerms 对分词结果去重，保持首次出现的顺序
+func uniqueTerms(tokens []token) []string {
+	seen := map[string]bool{}
+	var terms []string
+	for _, tok := range tokens {
+		if !seen[tok.term] {
+			seen[tok.term] = true
+			terms = append(terms, tok.term)
+		}
+	}
+	return terms
+}
+
+// localPage 返回 offset 在 pageBreaks 描述的分页中对应的章节内页码（从 1 开始）：
+// 统计起始偏移不晚于 offset 的分页数，即为 offset 所在的页
+func localPage(pageBreaks []int, offset int) int {
+	page := 0
+	for _, b := range pageBreaks {
+		if b > offset {
+			break
+		}
+		page++
+	}
+	if page == 0 {
+		page = 1
+	}
+	return page
+}
+
+// globalPage 把 bookID 下 chapter 章节内的 localPage 换算成全局页码：累加该书此前各章节的页数
+func globalPage(docs []docEntry, bookID string, chapter, localPage int) int {
+	page := localPage
+	for _, d := range docs {
+		if d.bookID == bookID && d.chapter < chapter {
+			page += len(d.pageBreaks)
+		}
+	}
+	return page
+}
+
+// snippet 截取 offset 处命中词项（长度 termLen）前后各 radius 个 rune 作为摘要，命中词项用【】标出
+func snippet(content string, offset, termLen, radius int) string {
+	runes := []rune(content)
+	start := offset - radius
+	if start < 0 {
+		start = 0
+	}
+	end := offset + termLen + radius
+	if end > len(runes) {
+		end = len(runes)
+	}
+	if offset < 0 || offset+termLen > len(runes) {
+		return string(runes[start:end])
+	}
+
+	var b strings.Builder
+	b.WriteString(string(runes[start:offset]))
+	b.WriteString("【")
+	b.WriteString(string(runes[offset : offset+termLen]))
+	b.WriteString("】")
+	b.WriteString(string(runes[offset+termLen : end]))
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/28
+ * Time: 19:20
+ * Description: 分词器，中日韩统一表意文字按相邻双字滑动窗口切分，其余按字母数字单词切分
+ */
+
+// token 一个分词结果及其在原文中的 rune 偏移
+type token struct {
+	term   string // 词项，西文已转为小写
+	offset int    // 起始 rune 偏移（含）
+}
+
+// isCJK 判断 r 是否为中日韩统一表意文字，这类文字没有空白分词线索，
+// 按原书（如《三体》《百年孤独》）整词切分几乎不可行，因此退化为双字滑动窗口
+func isCJK(r rune) bool {
+	return r >= 0x4E00 && r <= 0x9FFF
+}
+
+// tokenize 把正文切分为词项序列：连续的西文字母数字合并为一个单词（小写化），
+// 连续的 CJK 文字按相邻两字滑动窗口生成重叠的双字词项，标点与空白仅作为分隔符，不生成词项
+func tokenize(text string) []token {
+	runes := []rune(text)
+	var tokens []token
+
+	wordStart := -1
+	flushWord := func(end int) {
+		if wordStart < 0 {
+			return
+		}
+		tokens = append(tokens, token{term: strings.ToLower(string(runes[wordStart:end])), offset: wordStart})
+		wordStart = -1
+	}
+
+	for i, r := range runes {
+		switch {
+		case isCJK(r):
+			flushWord(i)
+			if i+1 < len(runes) && isCJK(runes[i+1]) {
+				tokens = append(tokens, token{term: string(runes[i : i+2]), offset: i})
+			}
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if wordStart < 0 {
+				wordStart = i
+			}
+		default:
+			flushWord(i)
+		}
+	}
+	flushWord(len(runes))
+
+	return tokens
+}
@@ -0,0 +1,147 @@
+package source
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/21
+ * Time: 09:45
+ * Description: 有界并发的章节抓取器，按 host 限速，逐章落盘避免内存堆积
+ */
+
+const (
+	DefaultConcurrency = 4                      // 默认并发抓取的 worker 数量
+	DefaultRateLimit   = 500 * time.Millisecond // 默认每个 host 两次请求之间的最小间隔
+)
+
+// Crawler 按 TOC 顺序并发抓取章节正文，抓到即落盘，最终按原始顺序产出文件路径列表
+type Crawler struct {
+	Concurrency int           // 并发抓取的 worker 数量，<= 0 时退回 DefaultConcurrency
+	RateLimit   time.Duration // 每个 host 两次请求之间的最小间隔，<= 0 时退回 DefaultRateLimit
+
+	mu      sync.Mutex
+	lastHit map[string]time.Time // 各 host 上一次请求的时间
+}
+
+// NewCrawler 创建抓取器
+func NewCrawler(concurrency int, rateLimit time.Duration) *Crawler {
+	return &Crawler{
+		Concurrency: concurrency,
+		RateLimit:   rateLimit,
+		lastHit:     make(map[string]time.Time),
+	}
+}
+
+// chapterResult 单章抓取结果，error 非空时表示该章抓取失败
+type chapterResult struct {
+	index int
+	title string
+	path  string
+	err   error
+}
+
+// Fetch 并发抓取 toc 中的每一章正文并写入 destDir 下按序号命名的文件，
+// progress 在每写完一章后被调用一次（done, total）；遇到首个错误即停止并返回该错误
+func (c *Crawler) Fetch(src NovelSource, toc []Chapter, destDir string, progress func(done, total int)) ([]string, error) {
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	// 外层 channel 按提交顺序持有每一章的内层 channel，worker 抓取完成即写入内层 channel，
+	// 但消费方始终按外层 channel 的顺序依次取用，从而保证结果按 TOC 原始顺序落地
+	outer := make(chan chan chapterResult, len(toc))
+	sem := make(chan struct{}, concurrency)
+	quit := make(chan struct{}) // 消费方遇到错误时关闭，停止提交剩余章节
+	var wg sync.WaitGroup
+
+	go func() {
+		defer close(outer)
+	submit:
+		for i, ch := range toc {
+			select {
+			case <-quit:
+				break submit
+			default:
+			}
+			inner := make(chan chapterResult, 1)
+			outer <- inner
+
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int, ch Chapter, inner chan chapterResult) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				c.waitTurn(ch.URL)
+				content, err := src.FetchChapter(ch.URL)
+				if err != nil {
+					inner <- chapterResult{index: i, err: err}
+					return
+				}
+
+				path := filepath.Join(destDir, fmt.Sprintf("%04d.txt", i+1))
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					inner <- chapterResult{index: i, err: err}
+					return
+				}
+				inner <- chapterResult{index: i, title: ch.Title, path: path}
+			}(i, ch, inner)
+		}
+		wg.Wait()
+	}()
+
+	paths := make([]string, 0, len(toc))
+	done := 0
+	for inner := range outer {
+		res := <-inner
+		if res.err != nil {
+			close(quit)
+			return paths, fmt.Errorf("source: 抓取第 %d 章失败: %w", res.index+1, res.err)
+		}
+		paths = append(paths, res.path)
+		done++
+		if progress != nil {
+			progress(done, len(toc))
+		}
+	}
+	return paths, nil
+}
+
+// waitTurn 按 RateLimit 阻塞，保证同一 host 的相邻两次请求间隔不小于限速值
+func (c *Crawler) waitTurn(rawURL string) {
+	limit := c.RateLimit
+	if limit <= 0 {
+		limit = DefaultRateLimit
+	}
+
+	host := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	c.mu.Lock()
+	wait := time.Duration(0)
+	if last, ok := c.lastHit[host]; ok {
+		if elapsed := time.Since(last); elapsed < limit {
+			wait = limit - elapsed
+		}
+	}
+	c.lastHit[host] = time.Now().Add(wait)
+	c.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,59 @@
+package source
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/21
+ * Time: 09:30
+ * Description: 在线小说源接口及按 host 注册的源列表
+ */
+
+// BookInfo 搜索结果中的一本书，URL 可直接作为 Booker.AddFromURL 的入参
+type BookInfo struct {
+	Title    string // 书名
+	Author   string // 作者
+	URL      string // 书籍详情页地址，FetchTOC 的入参
+	CoverURL string // 封面图片地址
+}
+
+// Chapter 远程目录中的一个章节条目，FetchChapter 根据 URL 取回正文
+type Chapter struct {
+	Title string // 章节标题
+	URL   string // 章节正文地址
+}
+
+// NovelSource 在线小说源，社区可实现此接口接入不同站点
+type NovelSource interface {
+	// Search 按关键字搜索书籍
+	Search(keyword string) ([]BookInfo, error)
+	// FetchTOC 拉取书籍目录
+	FetchTOC(bookURL string) ([]Chapter, error)
+	// FetchChapter 拉取单章正文
+	FetchChapter(chapterURL string) (string, error)
+}
+
+// registry 按 host 注册的小说源
+var registry = map[string]NovelSource{}
+
+// Register 注册一个 host 对应的小说源，host 不区分大小写
+func Register(host string, s NovelSource) {
+	registry[strings.ToLower(host)] = s
+}
+
+// ForURL 根据书籍地址的 host 返回对应的小说源
+func ForURL(rawURL string) (NovelSource, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := registry[strings.ToLower(u.Host)]
+	if !ok {
+		return nil, fmt.Errorf("source: 未注册 host %q 对应的小说源", u.Host)
+	}
+	return s, nil
+}
@@ -0,0 +1,32 @@
+package store
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/23
+ * Time: 11:00
+ * Description: 分组列表与组内书籍顺序的持久化接口及数据结构
+ */
+
+// GroupRecord 持久化的分组记录
+type GroupRecord struct {
+	ID        string // 分组唯一标识
+	Name      string // 分组名称
+	Order     int    // 分组在书架中的排序
+	CoverPath string // 分组封面
+}
+
+// GroupData 分组列表与组内书籍顺序的完整快照；BookPositions 以分组名为键，
+// 值为该分组内书籍 ID（文件路径）按顺序排列的列表
+type GroupData struct {
+	Groups        []GroupRecord
+	BookPositions map[string][]string
+}
+
+// GroupStore 持久化分组列表与组内书籍顺序，两者作为一个整体原子地读写，
+// 这样拖拽排序产生的分组顺序与组内顺序不会出现写入不一致
+type GroupStore interface {
+	// LoadGroups 读取分组列表与组内书籍顺序；从未保存过时返回空的 GroupData，不视为错误
+	LoadGroups() (*GroupData, error)
+	// SaveGroups 原子地写入分组列表与组内书籍顺序
+	SaveGroups(data *GroupData) error
+}
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/23
+ * Time: 11:05
+ * Description: 基于单个 JSON 文件的 GroupStore 实现
+ */
+
+// groupFileName 分组快照的文件名，与每本书各一个文件的 jsonStore 不同，分组数据整体写入一个文件
+const groupFileName = "groups.json"
+
+// jsonGroupStore 把分组列表与组内书籍顺序整体存成一个 JSON 文件
+type jsonGroupStore struct {
+	root string // 存储根目录
+}
+
+// NewJSONGroupStore 创建基于 JSON 文件的 GroupStore，root 通常取自 fyne.App.Storage().RootURI().Path()
+func NewJSONGroupStore(root string) GroupStore {
+	return &jsonGroupStore{root: root}
+}
+
+// path 分组快照文件的完整路径
+func (s *jsonGroupStore) path() string {
+	return filepath.Join(s.root, groupFileName)
+}
+
+// LoadGroups 读取分组快照；文件不存在时返回空的 GroupData，不视为错误
+func (s *jsonGroupStore) LoadGroups() (*GroupData, error) {
+	raw, err := os.ReadFile(s.path())
+	if os.IsNotExist(err) {
+		return &GroupData{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data GroupData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// SaveGroups 原子地写入分组快照（先写临时文件再重命名覆盖），存储根目录不存在时自动创建
+func (s *jsonGroupStore) SaveGroups(data *GroupData) error {
+	if err := os.MkdirAll(s.root, 0o755); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path() + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path())
+}
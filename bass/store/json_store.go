@@ -0,0 +1,127 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/15
+ * Time: 10:12
+ * Description: 基于 JSON 文件的 Store 实现，每本书一个文件
+ */
+
+// jsonStore 把每本书的标注数据各自存成一个 JSON 文件
+type jsonStore struct {
+	root string // 存储根目录
+}
+
+// NewJSONStore 创建基于 JSON 文件的 Store，root 通常取自 fyne.App.Storage().RootURI().Path()
+func NewJSONStore(root string) Store {
+	return &jsonStore{root: root}
+}
+
+// pathFor 把 bookID（通常是书籍文件路径）转换成存储根目录下的 JSON 文件路径
+func (s *jsonStore) pathFor(bookID string) string {
+	safe := strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(bookID)
+	return filepath.Join(s.root, safe+".json")
+}
+
+// Load 读取指定书籍的标注数据；文件不存在时返回空的 BookData，不视为错误
+func (s *jsonStore) Load(bookID string) (*BookData, error) {
+	raw, err := os.ReadFile(s.pathFor(bookID))
+	if os.IsNotExist(err) {
+		return &BookData{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data BookData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// Save 将标注数据写入指定书籍的 JSON 文件，存储根目录不存在时自动创建
+func (s *jsonStore) Save(bookID string, data *BookData) error {
+	if err := os.MkdirAll(s.root, 0o755); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.pathFor(bookID), raw, 0o644)
+}
+
+// queueFileName 书架队列顺序的文件名，与每本书各一个文件的标注数据不同，整体写入一个文件
+const queueFileName = "queue.json"
+
+// settingsFileName 阅读器设置的文件名
+const settingsFileName = "settings.json"
+
+// LoadQueue 读取上次保存的书架队列；文件不存在时返回空切片，不视为错误
+func (s *jsonStore) LoadQueue() ([]string, error) {
+	raw, err := os.ReadFile(filepath.Join(s.root, queueFileName))
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	if err := json.Unmarshal(raw, &paths); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// SaveQueue 保存当前书架队列的书籍文件路径顺序
+func (s *jsonStore) SaveQueue(paths []string) error {
+	if err := os.MkdirAll(s.root, 0o755); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(paths, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.root, queueFileName), raw, 0o644)
+}
+
+// LoadSettings 读取上次保存的阅读器设置；文件不存在时返回 nil，不视为错误
+func (s *jsonStore) LoadSettings() (*Settings, error) {
+	raw, err := os.ReadFile(filepath.Join(s.root, settingsFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var settings Settings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveSettings 保存阅读器设置，存储根目录不存在时自动创建
+func (s *jsonStore) SaveSettings(settings *Settings) error {
+	if err := os.MkdirAll(s.root, 0o755); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(s.root, settingsFileName), raw, 0o644)
+}
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	_ "modernc.org/sqlite"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/25
+ * Time: 14:10
+ * Description: 基于 SQLite 的 Store 实现，启动时按版本号依次执行迁移脚本
+ */
+
+// migrations 按顺序执行的建表脚本，下标 i 对应 schema 版本 i+1；新增迁移只需在末尾追加，
+// 不应修改已发布的迁移内容
+var migrations = []string{
+	`CREATE TABLE book_data (
+		book_id      TEXT PRIMARY KEY,
+		current_page INTEGER NOT NULL DEFAULT 0,
+		last_page    INTEGER NOT NULL DEFAULT 0,
+		progress     REAL NOT NULL DEFAULT 0,
+		last_read    TEXT NOT NULL DEFAULT '',
+		scale_factor REAL NOT NULL DEFAULT 0,
+		bookmarks    TEXT NOT NULL DEFAULT '[]',
+		highlights   TEXT NOT NULL DEFAULT '[]'
+	);`,
+	`CREATE TABLE queue (
+		position INTEGER PRIMARY KEY,
+		book_id  TEXT NOT NULL
+	);`,
+	`CREATE TABLE settings (
+		id                  INTEGER PRIMARY KEY CHECK (id = 1),
+		read_style          INTEGER NOT NULL DEFAULT 0,
+		auto_scroll_enabled INTEGER NOT NULL DEFAULT 0,
+		make_book_mark      INTEGER NOT NULL DEFAULT 0,
+		show_status_bar     INTEGER NOT NULL DEFAULT 0,
+		recent_page_count   INTEGER NOT NULL DEFAULT 0,
+		scroll_speed        REAL NOT NULL DEFAULT 0,
+		page_margin         REAL NOT NULL DEFAULT 0
+	);`,
+}
+
+// sqliteStore 基于 SQLite 的 Store 实现
+type sqliteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore 打开（或创建）path 指向的 SQLite 数据库并执行尚未应用的迁移
+func NewSQLiteStore(path string) (Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteStore{db: db}, nil
+}
+
+// migrate 依次执行尚未应用的迁移脚本，已应用的版本记录在 schema_migrations 表中
+func migrate(db *sql.DB) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`); err != nil {
+		return err
+	}
+
+	for i, stmt := range migrations {
+		version := i + 1
+
+		var applied int
+		if err := db.QueryRow(`SELECT COUNT(1) FROM schema_migrations WHERE version = ?`, version).Scan(&applied); err != nil {
+			return err
+		}
+		if applied > 0 {
+			continue
+		}
+
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+		if _, err := db.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load 读取指定书籍的标注与进度数据；书籍从未保存过时返回空的 BookData，不视为错误
+func (s *sqliteStore) Load(bookID string) (*BookData, error) {
+	row := s.db.QueryRow(`SELECT current_page, last_page, progress, last_read, scale_factor, bookmarks, highlights
+		FROM book_data WHERE book_id = ?`, bookID)
+
+	var data BookData
+	var lastRead, bookmarksJSON, highlightsJSON string
+	err := row.Scan(&data.CurrentPage, &data.LastPage, &data.Progress, &lastRead, &data.ScaleFactor, &bookmarksJSON, &highlightsJSON)
+	if err == sql.ErrNoRows {
+		return &BookData{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lastRead != "" {
+		if err := data.LastRead.UnmarshalText([]byte(lastRead)); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal([]byte(bookmarksJSON), &data.Bookmarks); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(highlightsJSON), &data.Highlights); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// Save 将标注与进度数据写入指定书籍，记录已存在时整体覆盖
+func (s *sqliteStore) Save(bookID string, data *BookData) error {
+	lastRead, err := data.LastRead.MarshalText()
+	if err != nil {
+		return err
+	}
+	bookmarksJSON, err := json.Marshal(data.Bookmarks)
+	if err != nil {
+		return err
+	}
+	highlightsJSON, err := json.Marshal(data.Highlights)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(`INSERT INTO book_data (book_id, current_page, last_page, progress, last_read, scale_factor, bookmarks, highlights)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (book_id) DO UPDATE SET
+			current_page = excluded.current_page,
+			last_page = excluded.last_page,
+			progress = excluded.progress,
+			last_read = excluded.last_read,
+			scale_factor = excluded.scale_factor,
+			bookmarks = excluded.bookmarks,
+			highlights = excluded.highlights`,
+		bookID, data.CurrentPage, data.LastPage, data.Progress, string(lastRead), data.ScaleFactor, string(bookmarksJSON), string(highlightsJSON))
+	return err
+}
+
+// LoadQueue 读取上次保存的书架队列；从未保存过时返回空切片
+func (s *sqliteStore) LoadQueue() ([]string, error) {
+	rows, err := s.db.Query(`SELECT book_id FROM queue ORDER BY position ASC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	paths := []string{}
+	for rows.Next() {
+		var bookID string
+		if err := rows.Scan(&bookID); err != nil {
+			return nil, err
+		}
+		paths = append(paths, bookID)
+	}
+	return paths, rows.Err()
+}
+
+// SaveQueue 原子地覆盖保存当前书架队列的书籍文件路径顺序
+func (s *sqliteStore) SaveQueue(paths []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM queue`); err != nil {
+		return err
+	}
+	for i, path := range paths {
+		if _, err := tx.Exec(`INSERT INTO queue (position, book_id) VALUES (?, ?)`, i, path); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// LoadSettings 读取上次保存的阅读器设置；从未保存过时返回 nil
+func (s *sqliteStore) LoadSettings() (*Settings, error) {
+	row := s.db.QueryRow(`SELECT read_style, auto_scroll_enabled, make_book_mark, show_status_bar, recent_page_count, scroll_speed, page_margin
+		FROM settings WHERE id = 1`)
+
+	var settings Settings
+	err := row.Scan(&settings.ReadStyle, &settings.AutoScrollEnabled, &settings.MakeBookMark, &settings.ShowStatusBar,
+		&settings.RecentPageCount, &settings.ScrollSpeed, &settings.PageMargin)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// SaveSettings 保存阅读器设置，记录已存在时整体覆盖
+func (s *sqliteStore) SaveSettings(settings *Settings) error {
+	_, err := s.db.Exec(`INSERT INTO settings (id, read_style, auto_scroll_enabled, make_book_mark, show_status_bar, recent_page_count, scroll_speed, page_margin)
+		VALUES (1, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			read_style = excluded.read_style,
+			auto_scroll_enabled = excluded.auto_scroll_enabled,
+			make_book_mark = excluded.make_book_mark,
+			show_status_bar = excluded.show_status_bar,
+			recent_page_count = excluded.recent_page_count,
+			scroll_speed = excluded.scroll_speed,
+			page_margin = excluded.page_margin`,
+		settings.ReadStyle, settings.AutoScrollEnabled, settings.MakeBookMark, settings.ShowStatusBar,
+		settings.RecentPageCount, settings.ScrollSpeed, settings.PageMargin)
+	return err
+}
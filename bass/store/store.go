@@ -0,0 +1,73 @@
+package store
+
+import (
+	"image/color"
+	"time"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/15
+ * Time: 10:05
+ * Description: 书签与高亮的持久化接口及数据结构
+ */
+
+// Bookmark 持久化的书签记录
+type Bookmark struct {
+	Page      int       // 书签页码
+	Desc      string    // 书签描述
+	CreatedAt time.Time // 创建时间
+}
+
+// Highlight 持久化的高亮记录
+type Highlight struct {
+	ID           string      // 高亮唯一标识
+	ChapterIndex int         // 所在章节下标
+	StartOffset  int         // 起始字符偏移（含）
+	EndOffset    int         // 结束字符偏移（不含）
+	Color        color.NRGBA // 高亮颜色
+	Note         string      // 附加笔记，可为空
+	CreatedAt    time.Time   // 创建时间
+	UpdatedAt    time.Time   // 最后修改时间
+}
+
+// BookData 单本书籍需要持久化的全部标注与阅读进度数据。旧版本只写入过 Bookmarks 字段的文件，
+// 反序列化时新增字段会自然落到零值，无需额外的迁移步骤。
+type BookData struct {
+	Bookmarks   []Bookmark
+	Highlights  []Highlight
+	CurrentPage int       // 当前页码
+	LastPage    int       // 上次阅读页码
+	Progress    float32   // 阅读进度（百分比）
+	LastRead    time.Time // 上次阅读时间
+	ScaleFactor float32   // 缩放比例
+}
+
+// Settings 持久化的阅读器设置
+type Settings struct {
+	ReadStyle         int     // 阅读方式
+	AutoScrollEnabled bool    // 自动滚动启用
+	MakeBookMark      bool    // 打书签
+	ShowStatusBar     bool    // 显示状态栏
+	RecentPageCount   int     // 最近显示数量
+	ScrollSpeed       float32 // 滚动速度
+	PageMargin        float32 // 页面间距
+}
+
+// Store 持久化单本书籍的标注与进度、阅读队列顺序、阅读器设置
+type Store interface {
+	// Load 读取指定书籍的标注与进度数据；书籍从未保存过时返回空的 BookData，不视为错误
+	Load(bookID string) (*BookData, error)
+	// Save 将标注与进度数据写入指定书籍
+	Save(bookID string, data *BookData) error
+
+	// LoadQueue 读取上次保存的书架队列（按书籍文件路径排列）；从未保存过时返回空切片
+	LoadQueue() ([]string, error)
+	// SaveQueue 保存当前书架队列的书籍文件路径顺序
+	SaveQueue(paths []string) error
+
+	// LoadSettings 读取上次保存的阅读器设置；从未保存过时返回 nil
+	LoadSettings() (*Settings, error)
+	// SaveSettings 保存阅读器设置
+	SaveSettings(settings *Settings) error
+}
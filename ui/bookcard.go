@@ -62,7 +62,7 @@ func (c *BookCard) createContent() {
 	coverContainer := container.NewStack(coverImg, coverBorder)
 
 	// 创建书名标签
-	titleText := canvas.NewText(c.config.Title, color.Black)
+	titleText := canvas.NewText(c.config.Title, theme.ForegroundColor())
 	titleText.TextSize = theme.TextSize() - 2
 	titleText.TextStyle = fyne.TextStyle{Bold: true}
 
@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"goread/bass"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/18
+ * Time: 19:45
+ * Description: 书架视图，支持关键字搜索、状态筛选与排序
+ */
+
+const filterDebounce = time.Millisecond * 300 // 搜索输入的防抖时长
+
+// statusOption 状态筛选弹窗里一个勾选项对应的状态与文案
+type statusOption struct {
+	status bass.ReadStatus
+	label  string
+}
+
+// statusOptions 状态筛选弹窗展示的全部状态，顺序与勾选框一一对应
+var statusOptions = []statusOption{
+	{bass.Unread, "未读"},
+	{bass.Reading, "在读"},
+	{bass.Finished, "已读完"},
+	{bass.OnHold, "搁置"},
+	{bass.Abandoned, "已弃读"},
+}
+
+// sortOption 排序下拉框里一个选项对应的排序字段与文案
+type sortOption struct {
+	sortBy bass.SortKey
+	label  string
+}
+
+// sortOptions 排序下拉框展示的全部排序字段，顺序与 bass.SortKey 一一对应
+var sortOptions = []sortOption{
+	{bass.SortByLastRead, "最近阅读"},
+	{bass.SortByProgress, "阅读进度"},
+	{bass.SortByName, "书名"},
+}
+
+// BookshelfView 书架视图：顶部是搜索框、状态筛选与排序控件，下方是匹配书籍的网格，
+// 三者任一变化都会重新调用 Booker.Query 并刷新网格
+type BookshelfView struct {
+	booker *bass.Booker
+
+	entry        *widget.Entry
+	statusButton *widget.Button
+	statusPopup  *widget.PopUp
+	statusChecks []*widget.Check
+	sortSelect   *widget.Select
+
+	grid      *fyne.Container
+	container *fyne.Container
+
+	debounceTimer *time.Timer
+}
+
+// NewBookshelfView 创建书架视图
+func NewBookshelfView(booker *bass.Booker) *BookshelfView {
+	v := &BookshelfView{booker: booker}
+
+	v.entry = widget.NewEntry()
+	v.entry.SetPlaceHolder("搜索书名或分组")
+	v.entry.OnChanged = func(string) { v.debounceRefresh() }
+
+	v.statusButton = widget.NewButton("状态", v.showStatusPopup)
+
+	sortLabels := make([]string, len(sortOptions))
+	for i, opt := range sortOptions {
+		sortLabels[i] = opt.label
+	}
+	v.sortSelect = widget.NewSelect(sortLabels, func(string) { v.Refresh() })
+
+	controls := container.NewBorder(nil, nil, nil, container.NewHBox(v.statusButton, v.sortSelect), v.entry)
+
+	v.grid = container.NewGridWrap(fyne.NewSize(bass.ConverW, bass.ConverH+60))
+	v.container = container.NewBorder(controls, nil, nil, nil, v.grid)
+
+	v.sortSelect.SetSelectedIndex(0) // 触发 OnChanged，完成首次查询
+	return v
+}
+
+// GetView 获取视图对象
+func (v *BookshelfView) GetView() fyne.CanvasObject {
+	return v.container
+}
+
+// ApplyFilter 用给定关键字替换搜索框内容并重新查询，供标签头的搜索栏调用
+func (v *BookshelfView) ApplyFilter(keyword string) {
+	v.entry.SetText(keyword)
+}
+
+// debounceRefresh 在搜索框停止输入 filterDebounce 时长后才真正重新查询，避免逐字符触发
+func (v *BookshelfView) debounceRefresh() {
+	if v.debounceTimer != nil {
+		v.debounceTimer.Stop()
+	}
+	v.debounceTimer = time.AfterFunc(filterDebounce, v.Refresh)
+}
+
+// showStatusPopup 弹出状态多选框，勾选变化立即重新查询
+func (v *BookshelfView) showStatusPopup() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(v.statusButton)
+	if c == nil {
+		return
+	}
+
+	if len(v.statusChecks) == 0 {
+		v.statusChecks = make([]*widget.Check, len(statusOptions))
+		for i, opt := range statusOptions {
+			v.statusChecks[i] = widget.NewCheck(opt.label, func(bool) { v.Refresh() })
+		}
+	}
+
+	items := make([]fyne.CanvasObject, len(v.statusChecks))
+	for i, check := range v.statusChecks {
+		items[i] = check
+	}
+
+	v.statusPopup = widget.NewPopUp(container.NewVBox(items...), c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(v.statusButton)
+	v.statusPopup.ShowAtPosition(pos.Add(fyne.NewPos(0, v.statusButton.Size().Height)))
+}
+
+// selectedStatuses 返回状态多选框里当前勾选的状态集合，从未打开过筛选弹窗时视为不筛选
+func (v *BookshelfView) selectedStatuses() []bass.ReadStatus {
+	var statuses []bass.ReadStatus
+	for i, check := range v.statusChecks {
+		if check.Checked {
+			statuses = append(statuses, statusOptions[i].status)
+		}
+	}
+	return statuses
+}
+
+// Refresh 按当前的搜索关键字、状态筛选与排序重新查询并重建网格
+func (v *BookshelfView) Refresh() {
+	filter := bass.BookFilter{
+		Keyword:  v.entry.Text,
+		Statuses: v.selectedStatuses(),
+		SortBy:   sortOptions[v.sortSelect.SelectedIndex()].sortBy,
+	}
+
+	v.grid.Objects = nil
+	for _, book := range v.booker.Query(filter) {
+		book := book
+		v.grid.Add(NewBookCard(BookCardConfig{
+			CoverPath: book.CoverPath,
+			Title:     book.Name,
+			Progress:  fmt.Sprintf("已读 %.1f%%", book.Progress),
+			FilePath:  book.FilePath,
+			OnTap:     func() { _ = v.booker.OpenBook(book.FilePath) },
+		}))
+	}
+	v.grid.Refresh()
+}
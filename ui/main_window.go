@@ -1,7 +1,12 @@
 package ui
 
 import (
+	"path/filepath"
+
 	"goread/bass"
+	"goread/bass/search"
+	"goread/bass/store"
+	"goread/ui/themes"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
@@ -17,6 +22,8 @@ import (
  * Description: 主界面
  */
 
+const appearancePrefKey = "appearance" // 首选项键：外观模式，取值 light/dark/system
+
 type MainWindow struct {
 	app    fyne.App    // 应用实例
 	window fyne.Window // 主窗口实例
@@ -24,10 +31,15 @@ type MainWindow struct {
 	// 控制按钮
 	searchButton *widget.Button
 	moreButton   *widget.Button
+	searchBar    *searchOverlay // 点击 searchButton 后滑下的搜索栏
+
+	// 标签页
+	tabs *TabContainer
 
 	// 阅读器
-	booker     *bass.Booker
-	recentView *RecentBooksView
+	booker        *bass.Booker
+	recentView    *RecentBooksView
+	bookshelfView *BookshelfView
 }
 
 func NewMainWindow() *MainWindow {
@@ -36,11 +48,20 @@ func NewMainWindow() *MainWindow {
 	a := app.NewWithID(bass.PACKAGE)
 	w := a.NewWindow(bass.APPNAME)
 
-	// 初始化阅读器和视图
-	// 加载示例数据
+	// 初始化阅读器，挂载持久化实现后恢复上次保存的设置、队列与分组
 	mw.booker = bass.NewBooker(bass.BookerCallback{})
+	root := a.Storage().RootURI().Path()
+	mw.booker.SetStore(store.NewJSONStore(root))
+	mw.booker.SetGroupStore(store.NewJSONGroupStore(root))
+	mw.booker.SetSearchIndex(search.NewSearchIndex(filepath.Join(root, "search.index")))
+	mw.booker.RestoreSettings()
+	mw.booker.RestoreQueue()
+	mw.booker.LoadGroups()
+
+	// 加载示例数据
 	mw.booker.LoadSampleBooks()
 	mw.recentView = NewRecentBooksView(mw.booker)
+	mw.bookshelfView = NewBookshelfView(mw.booker)
 
 	mw.app = a
 	mw.window = w
@@ -49,27 +70,82 @@ func NewMainWindow() *MainWindow {
 		Height: bass.HEIGHT,
 	})
 
+	// 按上次持久化的外观模式应用主题，默认跟随系统
+	mw.applyAppearance(a.Preferences().StringWithFallback(appearancePrefKey, "system"))
+
 	return &mw
 }
 
+// applyAppearance 按 mode（light/dark/system）切换主题并持久化到 Preferences
+func (mw *MainWindow) applyAppearance(mode string) {
+	switch mode {
+	case "light":
+		mw.app.Settings().SetTheme(themes.LightTheme(themes.ThemeConfig{}))
+	case "dark":
+		mw.app.Settings().SetTheme(themes.DarkTheme(themes.ThemeConfig{}))
+	default:
+		mode = "system"
+		mw.app.Settings().SetTheme(theme.DefaultTheme())
+	}
+	mw.app.Preferences().SetString(appearancePrefKey, mode)
+}
+
+// showMoreMenu 弹出 moreButton 的菜单，目前只有一个展开 Light/Dark/System 选项的“外观”子菜单
+func (mw *MainWindow) showMoreMenu() {
+	appearance := fyne.NewMenuItem("外观", nil)
+	appearance.ChildMenu = fyne.NewMenu("",
+		fyne.NewMenuItem("浅色", func() { mw.applyAppearance("light") }),
+		fyne.NewMenuItem("深色", func() { mw.applyAppearance("dark") }),
+		fyne.NewMenuItem("跟随系统", func() { mw.applyAppearance("system") }),
+	)
+
+	menu := fyne.NewMenu("", appearance)
+	c := fyne.CurrentApp().Driver().CanvasForObject(mw.moreButton)
+	if c == nil {
+		return
+	}
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(mw.moreButton)
+	pop := widget.NewPopUpMenu(menu, c)
+	pop.ShowAtPosition(pos.Add(fyne.NewPos(0, mw.moreButton.Size().Height)))
+}
+
 func (mw *MainWindow) controls() fyne.CanvasObject {
 	// 创建无背景的搜索按钮和更多选项按钮
-	mw.searchButton = &widget.Button{Icon: theme.SearchIcon(), OnTapped: func() {}, Importance: widget.LowImportance}
-	mw.moreButton = &widget.Button{Icon: theme.MoreVerticalIcon(), OnTapped: func() {}, Importance: widget.LowImportance}
+	mw.searchButton = &widget.Button{Icon: theme.SearchIcon(), OnTapped: mw.toggleSearch, Importance: widget.LowImportance}
+	mw.moreButton = &widget.Button{Icon: theme.MoreVerticalIcon(), OnTapped: mw.showMoreMenu, Importance: widget.LowImportance}
 
 	buttons := container.NewHBox(mw.searchButton, mw.moreButton)
 
 	// 创建标签页
-	tabs := NewTabContainer(
+	mw.tabs = NewTabContainer(
 		NewTabItem("最近", mw.recentView.GetView()),
-		NewTabItem("全部", widget.NewLabel("全部内容")),
+		NewTabItem("全部", mw.bookshelfView.GetView()),
 	)
 
+	// 搜索栏展开时叠加在标签头之上
+	mw.searchBar = newSearchOverlay(mw.filterActiveTab)
+	header := container.NewStack(mw.tabs.buttonBox, mw.searchBar)
+
 	// 创建顶部工具栏，只包含标签页头部和按钮
-	toolbar := container.NewBorder(nil, nil, nil, buttons, tabs.buttonBox)
+	toolbar := container.NewBorder(nil, nil, nil, buttons, header)
 
 	// 返回包含工具栏和内容的垂直布局
-	return container.NewBorder(toolbar, nil, nil, nil, tabs.content)
+	return container.NewBorder(toolbar, nil, nil, nil, mw.tabs.content)
+}
+
+// toggleSearch 展开或收起标签头上的搜索栏
+func (mw *MainWindow) toggleSearch() {
+	mw.searchBar.Toggle()
+}
+
+// filterActiveTab 把搜索栏的输入同步到当前激活的标签视图（「最近」或「全部」）
+func (mw *MainWindow) filterActiveTab(keyword string) {
+	switch mw.tabs.currentTab {
+	case 0:
+		mw.recentView.ApplyFilter(keyword)
+	case 1:
+		mw.bookshelfView.ApplyFilter(keyword)
+	}
 }
 
 func (mw *MainWindow) ShowAndRun() {
@@ -0,0 +1,636 @@
+package ui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+
+	"goread/bass"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/14
+ * Time: 21:30
+ * Description: 阅读器翻页视图，按 PageTurnMode 驱动不同的翻页动效
+ */
+
+const (
+	turnAnimDuration = time.Millisecond * 300 // 翻页动画时长
+	turnCommitRatio  = 0.3                    // 拖拽超过该比例的宽度即视为一次有效翻页
+	shadowWidth      = float32(24)            // Cover 模式前沿阴影的宽度
+	longPressDelay   = time.Millisecond * 450 // 长按触发选区的最短按住时长
+	highlightAlpha   = 0.4                    // 高亮底色相对原色的不透明度
+)
+
+// highlightSwatches 选区工具栏提供的高亮颜色：黄、绿、蓝、粉
+var highlightSwatches = []color.NRGBA{
+	{R: 255, G: 235, B: 59, A: 255},
+	{R: 139, G: 195, B: 74, A: 255},
+	{R: 33, G: 150, B: 243, A: 255},
+	{R: 233, G: 30, B: 99, A: 255},
+}
+
+// highlightBand 当前页上一条高亮的近似叠加区域：canvas.Text 不提供逐字形版式信息，
+// 这里按字符偏移在本页内的比例换算为页面顶部一条水平色带的起止横坐标来近似高亮范围
+type highlightBand struct {
+	rect      *canvas.Rectangle
+	startFrac float32
+	endFrac   float32
+}
+
+// ReaderView 阅读器翻页视图，展示当前页文本并响应手势驱动的翻页动画
+type ReaderView struct {
+	widget.BaseWidget
+
+	booker *bass.Booker
+	mode   bass.PageTurnMode
+
+	currentPage *canvas.Text           // 当前页文本
+	incoming    *canvas.Text           // 翻页过程中进入视野的相邻页文本
+	shadow      *canvas.LinearGradient // Cover 模式下入页前沿的阴影
+	curl        *canvas.Raster         // Curl 模式下的卷页近似效果
+
+	scroll *container.Scroll // Scroll 模式下承载连续内容
+
+	dragOffset float32 // 当前拖拽/动画的水平偏移量（像素）
+	direction  int     // 手势方向：1 翻到下一页，-1 翻回上一页，0 未拖拽
+	dragging   bool    // 是否正处于拖拽中
+
+	animation *fyne.Animation // 提交/回弹翻页动画
+
+	highlightBands []highlightBand // 当前页命中的高亮叠加区域
+
+	pressTimer   *time.Timer   // 长按检测计时器
+	selecting    bool          // 是否正处于长按选区中
+	selectAnchor fyne.Position // 选区起点（按下位置）
+	selectEnd    fyne.Position // 选区终点（当前/松手位置）
+	selRange     [2]int        // 提交选区后，在当前页文本内的字符偏移区间 [start, end)
+	toolbar      *widget.PopUp // 选区浮动工具栏
+}
+
+// NewReaderView 创建阅读器翻页视图
+func NewReaderView(booker *bass.Booker, mode bass.PageTurnMode) *ReaderView {
+	rv := &ReaderView{
+		booker: booker,
+		mode:   mode,
+	}
+	rv.ExtendBaseWidget(rv)
+	rv.buildPages()
+	booker.SetScrollChangeCallback(rv.onScrollChange)
+	return rv
+}
+
+// onScrollChange 是 Booker 的滚动位置变化回调，把自动滚动等非手势触发的偏移量同步到 Scroll 模式的视图上
+func (rv *ReaderView) onScrollChange(offset float32) {
+	if rv.mode != bass.PageTurnScroll || rv.scroll == nil {
+		return
+	}
+	rv.scroll.Offset.Y = offset
+	rv.scroll.Refresh()
+}
+
+// SetMode 切换翻页模式
+func (rv *ReaderView) SetMode(mode bass.PageTurnMode) {
+	if rv.mode == mode {
+		return
+	}
+	rv.mode = mode
+	rv.buildPages()
+	rv.Refresh()
+}
+
+// buildPages 根据当前模式重建承载页面内容的画布对象
+func (rv *ReaderView) buildPages() {
+	rv.currentPage = canvas.NewText(rv.booker.CurrentPageText(), theme.ForegroundColor())
+	rv.currentPage.TextSize = bass.DefaultFontSize
+
+	rv.dragOffset = 0
+	rv.direction = 0
+
+	if rv.mode == bass.PageTurnScroll {
+		rv.scroll = container.NewVScroll(rv.currentPage)
+		rv.incoming = nil
+		rv.shadow = nil
+		rv.curl = nil
+		return
+	}
+	rv.scroll = nil
+
+	rv.incoming = canvas.NewText("", theme.ForegroundColor())
+	rv.incoming.TextSize = bass.DefaultFontSize
+	rv.incoming.Hide()
+
+	if rv.mode == bass.PageTurnCover {
+		rv.shadow = canvas.NewLinearGradient(color.NRGBA{A: 120}, color.Transparent, 0)
+		rv.shadow.Hide()
+	} else {
+		rv.shadow = nil
+	}
+
+	if rv.mode == bass.PageTurnCurl {
+		rv.curl = canvas.NewRaster(rv.generateCurl)
+		rv.curl.Hide()
+	} else {
+		rv.curl = nil
+	}
+
+	rv.buildHighlightBands()
+}
+
+// buildHighlightBands 为当前页命中的高亮生成近似叠加色带
+func (rv *ReaderView) buildHighlightBands() {
+	rv.highlightBands = nil
+
+	meta := rv.booker.GetCurrentBook()
+	if meta == nil {
+		return
+	}
+	_, pageStart, pageEnd, ok := rv.booker.CurrentPageRange()
+	if !ok || pageEnd <= pageStart {
+		return
+	}
+	total := float32(pageEnd - pageStart)
+
+	for _, h := range rv.booker.HighlightsForPage(meta.CurrentPage) {
+		start := h.StartOffset - pageStart
+		end := h.EndOffset - pageStart
+		if start < 0 {
+			start = 0
+		}
+		if end > pageEnd-pageStart {
+			end = pageEnd - pageStart
+		}
+		if end <= start {
+			continue
+		}
+
+		col := h.Color
+		col.A = uint8(float32(col.A) * highlightAlpha)
+		rv.highlightBands = append(rv.highlightBands, highlightBand{
+			rect:      canvas.NewRectangle(col),
+			startFrac: float32(start) / total,
+			endFrac:   float32(end) / total,
+		})
+	}
+}
+
+// MouseDown 实现 desktop.Mouseable：按下后启动长按计时器，超时未松手/未拖拽则进入选区模式
+func (rv *ReaderView) MouseDown(ev *desktop.MouseEvent) {
+	if ev.Button != desktop.MouseButtonPrimary {
+		return
+	}
+	rv.cancelPressTimer()
+	pos := ev.Position
+	rv.pressTimer = time.AfterFunc(longPressDelay, func() {
+		rv.selecting = true
+		rv.selectAnchor = pos
+		rv.selectEnd = pos
+		rv.Refresh()
+	})
+}
+
+// MouseUp 实现 desktop.Mouseable：松手时若已处于选区模式则按当前区间弹出高亮工具栏
+func (rv *ReaderView) MouseUp(ev *desktop.MouseEvent) {
+	rv.cancelPressTimer()
+	if !rv.selecting {
+		return
+	}
+	rv.selecting = false
+	rv.selectEnd = ev.Position
+	rv.commitSelection()
+}
+
+// cancelPressTimer 停止长按计时器，用于手指移动触发翻页手势或提前松手的场景
+func (rv *ReaderView) cancelPressTimer() {
+	if rv.pressTimer != nil {
+		rv.pressTimer.Stop()
+		rv.pressTimer = nil
+	}
+}
+
+// commitSelection 把选区起止位置换算成当前页文本内的字符偏移区间，并弹出高亮工具栏
+func (rv *ReaderView) commitSelection() {
+	width := rv.Size().Width
+	if width <= 0 {
+		return
+	}
+
+	start := rv.offsetAtX(rv.selectAnchor.X, width)
+	end := rv.offsetAtX(rv.selectEnd.X, width)
+	if start > end {
+		start, end = end, start
+	}
+	if end <= start {
+		return
+	}
+
+	rv.selRange = [2]int{start, end}
+	rv.showHighlightToolbar()
+}
+
+// offsetAtX 按水平位置在页面宽度中的占比，近似换算出对应的字符偏移；canvas.Text 不提供
+// 逐字形版式信息，这里与 buildHighlightBands 采用同一种按比例换算的近似方式
+func (rv *ReaderView) offsetAtX(x, width float32) int {
+	frac := x / width
+	switch {
+	case frac < 0:
+		frac = 0
+	case frac > 1:
+		frac = 1
+	}
+	runeCount := len([]rune(rv.currentPage.Text))
+	return int(frac * float32(runeCount))
+}
+
+// showHighlightToolbar 在选区末端弹出颜色工具栏，选择颜色后把选区保存为一条高亮
+func (rv *ReaderView) showHighlightToolbar() {
+	c := fyne.CurrentApp().Driver().CanvasForObject(rv)
+	if c == nil {
+		return
+	}
+
+	var swatches []fyne.CanvasObject
+	for _, swatch := range highlightSwatches {
+		col := swatch
+		rect := canvas.NewRectangle(col)
+		rect.SetMinSize(fyne.NewSize(24, 24))
+		swatches = append(swatches, newTappableIcon(rect, func() {
+			rv.addSelectionHighlight(col)
+		}))
+	}
+
+	rv.toolbar = widget.NewPopUp(container.NewHBox(swatches...), c)
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(rv)
+	rv.toolbar.ShowAtPosition(pos.Add(rv.selectEnd))
+}
+
+// addSelectionHighlight 把待提交的选区按指定颜色保存为高亮，并关闭工具栏
+func (rv *ReaderView) addSelectionHighlight(col color.NRGBA) {
+	if rv.toolbar != nil {
+		rv.toolbar.Hide()
+		rv.toolbar = nil
+	}
+
+	meta := rv.booker.GetCurrentBook()
+	chapterIndex, pageStart, _, ok := rv.booker.CurrentPageRange()
+	if meta == nil || !ok {
+		return
+	}
+
+	now := time.Now()
+	h := bass.Highlight{
+		ID:           fmt.Sprintf("hl-%d-%d-%d", chapterIndex, pageStart+rv.selRange[0], now.UnixNano()),
+		ChapterIndex: chapterIndex,
+		StartOffset:  pageStart + rv.selRange[0],
+		EndOffset:    pageStart + rv.selRange[1],
+		Color:        col,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	rv.booker.AddHighlight(h)
+	rv.buildHighlightBands()
+	rv.Refresh()
+}
+
+// Dragged 实现 fyne.Draggable：水平拖拽时按当前模式预演翻页
+func (rv *ReaderView) Dragged(ev *fyne.DragEvent) {
+	if rv.mode == bass.PageTurnNone || rv.mode == bass.PageTurnScroll {
+		return
+	}
+	rv.cancelPressTimer()
+	rv.dragging = true
+	rv.dragOffset += ev.Dragged.DX
+
+	switch {
+	case rv.dragOffset < 0:
+		rv.direction = 1 // 向左拖动，翻到下一页
+	case rv.dragOffset > 0:
+		rv.direction = -1 // 向右拖动，回到上一页
+	default:
+		rv.direction = 0
+	}
+
+	rv.updateIncomingText()
+	rv.Refresh()
+}
+
+// DragEnd 实现 fyne.Draggable：松手后判断是否提交本次翻页
+func (rv *ReaderView) DragEnd() {
+	if !rv.dragging {
+		return
+	}
+	rv.dragging = false
+
+	width := rv.Size().Width
+	if width > 0 && rv.direction != 0 && abs32(rv.dragOffset) >= width*turnCommitRatio {
+		rv.commitTurn(rv.direction)
+		return
+	}
+	rv.snapBack()
+}
+
+// updateIncomingText 根据拖拽方向预取相邻页文本，供 Slide/Cover/Curl 模式预览
+func (rv *ReaderView) updateIncomingText() {
+	if rv.incoming == nil {
+		return
+	}
+	if rv.direction == 0 {
+		rv.incoming.Hide()
+		return
+	}
+
+	meta := rv.booker.GetCurrentBook()
+	if meta == nil {
+		rv.incoming.Hide()
+		return
+	}
+
+	target := meta.CurrentPage + rv.direction
+	rv.incoming.Text = rv.booker.PageText(target)
+	rv.incoming.Show()
+}
+
+// commitTurn 提交一次翻页：先播放完剩余动画，再让 Booker 翻页并重建页面内容
+func (rv *ReaderView) commitTurn(direction int) {
+	width := rv.Size().Width
+	rv.playOffsetAnimation(rv.dragOffset, float32(-direction)*width, func() {
+		if direction > 0 {
+			rv.booker.NextPage()
+		} else {
+			rv.booker.PrevPage()
+		}
+		rv.buildPages()
+		rv.Refresh()
+	})
+}
+
+// snapBack 取消本次翻页：动画回弹到偏移 0
+func (rv *ReaderView) snapBack() {
+	rv.playOffsetAnimation(rv.dragOffset, 0, func() {
+		rv.direction = 0
+		rv.updateIncomingText()
+		rv.Refresh()
+	})
+}
+
+// playOffsetAnimation 以 ease-out 曲线在 [start, end] 间驱动 dragOffset，结束后执行 onDone
+func (rv *ReaderView) playOffsetAnimation(start, end float32, onDone func()) {
+	if rv.animation != nil {
+		rv.animation.Stop()
+	}
+	rv.animation = fyne.NewAnimation(turnAnimDuration, func(progress float32) {
+		rv.dragOffset = start + (end-start)*progress
+		rv.Refresh()
+		if progress == 1 && onDone != nil {
+			onDone()
+		}
+	})
+	rv.animation.Curve = fyne.AnimationEaseOut
+	rv.animation.Start()
+}
+
+// generateCurl 按当前拖拽进度生成卷页近似图像：用一条随 y 轻微倾斜的折线把页面分成左右两个
+// 区域，分别填充底色与折痕阴影色，模拟纸张翘起的明暗面。这是一种 shader-free 的仿射
+// 近似，不做逐字素捕获。
+func (rv *ReaderView) generateCurl(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	if w == 0 || h == 0 || rv.direction == 0 {
+		return img
+	}
+
+	width := rv.Size().Width
+	progress := float32(0)
+	if width > 0 {
+		progress = abs32(rv.dragOffset) / width
+	}
+
+	base := theme.BackgroundColor()
+	fold := color.NRGBA{R: 200, G: 200, B: 200, A: 160}
+
+	tiltSpan := 20
+	for y := 0; y < h; y++ {
+		tilt := int(float32(y) / float32(h) * float32(tiltSpan))
+		var edge int
+		if rv.direction > 0 {
+			edge = int(float32(w)*(1-progress)) + tilt - tiltSpan/2
+		} else {
+			edge = int(float32(w)*progress) - tilt + tiltSpan/2
+		}
+		for x := 0; x < w; x++ {
+			if (rv.direction > 0 && x >= edge) || (rv.direction < 0 && x <= edge) {
+				img.Set(x, y, fold)
+			} else {
+				img.Set(x, y, base)
+			}
+		}
+	}
+	return img
+}
+
+// tappableIcon 把任意画布对象包装成可点击的控件，用于选区工具栏上的颜色色块
+type tappableIcon struct {
+	widget.BaseWidget
+	icon  fyne.CanvasObject
+	onTap func()
+}
+
+// newTappableIcon 创建可点击的图标控件，点击时触发 onTap
+func newTappableIcon(icon fyne.CanvasObject, onTap func()) *tappableIcon {
+	t := &tappableIcon{icon: icon, onTap: onTap}
+	t.ExtendBaseWidget(t)
+	return t
+}
+
+// CreateRenderer 实现自定义渲染
+func (t *tappableIcon) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(t.icon)
+}
+
+// Tapped 处理点击事件
+func (t *tappableIcon) Tapped(*fyne.PointEvent) {
+	if t.onTap != nil {
+		t.onTap()
+	}
+}
+
+// abs32 返回 float32 的绝对值
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// CreateRenderer 创建阅读器视图的渲染器
+func (rv *ReaderView) CreateRenderer() fyne.WidgetRenderer {
+	return &readerViewRenderer{view: rv}
+}
+
+// readerViewRenderer 阅读器视图的渲染器，按 PageTurnMode 布局页面与特效对象
+type readerViewRenderer struct {
+	view *ReaderView
+}
+
+func (r *readerViewRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(bass.PageWidth, bass.PageHeight)
+}
+
+func (r *readerViewRenderer) Layout(size fyne.Size) {
+	rv := r.view
+	if rv.scroll != nil {
+		rv.scroll.Resize(size)
+		return
+	}
+
+	rv.currentPage.Resize(size)
+	if rv.incoming != nil {
+		rv.incoming.Resize(size)
+	}
+
+	switch rv.mode {
+	case bass.PageTurnSlide:
+		r.layoutSlide(size)
+	case bass.PageTurnCover:
+		r.layoutCover(size)
+	case bass.PageTurnCurl:
+		r.layoutCurl(size)
+	default:
+		rv.currentPage.Move(fyne.NewPos(0, 0))
+	}
+
+	r.layoutHighlightBands(size)
+}
+
+// layoutHighlightBands 把每条高亮的字符偏移区间按本页比例换算成页面顶部一条水平色带的起止横坐标
+func (r *readerViewRenderer) layoutHighlightBands(size fyne.Size) {
+	rv := r.view
+	for _, band := range rv.highlightBands {
+		band.rect.Resize(fyne.NewSize(size.Width*(band.endFrac-band.startFrac), bass.DefaultFontSize+4))
+		band.rect.Move(fyne.NewPos(size.Width*band.startFrac, 0))
+	}
+}
+
+// layoutSlide 出页整体跟随拖拽偏移横移，入页从对应的一侧跟进
+func (r *readerViewRenderer) layoutSlide(size fyne.Size) {
+	rv := r.view
+	rv.currentPage.Move(fyne.NewPos(rv.dragOffset, 0))
+	if rv.incoming == nil {
+		return
+	}
+	if rv.direction > 0 {
+		rv.incoming.Move(fyne.NewPos(size.Width+rv.dragOffset, 0))
+	} else if rv.direction < 0 {
+		rv.incoming.Move(fyne.NewPos(rv.dragOffset-size.Width, 0))
+	}
+}
+
+// layoutCover 出页保持静止，入页覆盖滑入，前沿绘制阴影
+func (r *readerViewRenderer) layoutCover(size fyne.Size) {
+	rv := r.view
+	rv.currentPage.Move(fyne.NewPos(0, 0))
+	if rv.incoming == nil {
+		return
+	}
+	if rv.direction == 0 {
+		rv.incoming.Hide()
+		if rv.shadow != nil {
+			rv.shadow.Hide()
+		}
+		return
+	}
+
+	var incomingX float32
+	if rv.direction > 0 {
+		incomingX = size.Width + rv.dragOffset
+	} else {
+		incomingX = rv.dragOffset - size.Width
+	}
+	rv.incoming.Move(fyne.NewPos(incomingX, 0))
+
+	if rv.shadow == nil {
+		return
+	}
+	var shadowX float32
+	if rv.direction > 0 {
+		shadowX = incomingX - shadowWidth
+	} else {
+		shadowX = incomingX + size.Width
+	}
+	rv.shadow.Resize(fyne.NewSize(shadowWidth, size.Height))
+	rv.shadow.Move(fyne.NewPos(shadowX, 0))
+	rv.shadow.Show()
+}
+
+// layoutCurl 铺满卷页光栅层，具体的折痕形状由 generateCurl 按拖拽进度生成
+func (r *readerViewRenderer) layoutCurl(size fyne.Size) {
+	rv := r.view
+	rv.currentPage.Move(fyne.NewPos(0, 0))
+	if rv.curl == nil {
+		return
+	}
+	if rv.direction == 0 {
+		rv.curl.Hide()
+		return
+	}
+	rv.curl.Resize(size)
+	rv.curl.Move(fyne.NewPos(0, 0))
+	rv.curl.Show()
+	rv.curl.Refresh()
+}
+
+func (r *readerViewRenderer) Refresh() {
+	rv := r.view
+	r.Layout(rv.Size())
+
+	if rv.scroll != nil {
+		rv.scroll.Refresh()
+		return
+	}
+	rv.currentPage.Refresh()
+	if rv.incoming != nil {
+		rv.incoming.Refresh()
+	}
+	if rv.shadow != nil {
+		rv.shadow.Refresh()
+	}
+	if rv.curl != nil {
+		rv.curl.Refresh()
+	}
+	for _, band := range rv.highlightBands {
+		band.rect.Refresh()
+	}
+}
+
+func (r *readerViewRenderer) Objects() []fyne.CanvasObject {
+	rv := r.view
+	if rv.scroll != nil {
+		return []fyne.CanvasObject{rv.scroll}
+	}
+
+	var objects []fyne.CanvasObject
+	for _, band := range rv.highlightBands {
+		objects = append(objects, band.rect)
+	}
+	objects = append(objects, rv.currentPage)
+	if rv.shadow != nil {
+		objects = append(objects, rv.shadow)
+	}
+	if rv.curl != nil {
+		objects = append(objects, rv.curl)
+	}
+	if rv.incoming != nil {
+		objects = append(objects, rv.incoming)
+	}
+	return objects
+}
+
+func (r *readerViewRenderer) Destroy() {}
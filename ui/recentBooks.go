@@ -24,6 +24,7 @@ import (
 type RecentBooksView struct {
 	booker    *bass.Booker
 	container *fyne.Container
+	filter    string // 搜索关键字，空表示展示默认的「最近阅读」列表
 }
 
 // NewRecentBooksView 创建最近阅读视图
@@ -45,8 +46,13 @@ func (r *RecentBooksView) GetView() fyne.CanvasObject {
 func (r *RecentBooksView) Refresh() {
 	r.container.Objects = nil // 清空现有内容
 
-	// 获取最近阅读的书籍
-	books := r.booker.GetRecentBooks()
+	// 有搜索关键字时按关键字查询，否则展示默认的「最近阅读」列表
+	var books []bass.BookMeta
+	if r.filter != "" {
+		books = r.booker.Query(bass.BookFilter{Keyword: r.filter, SortBy: bass.SortByLastRead})
+	} else {
+		books = r.booker.GetRecentBooks()
+	}
 
 	// 创建书籍卡片
 	for _, book := range books {
@@ -55,6 +61,13 @@ func (r *RecentBooksView) Refresh() {
 	}
 }
 
+// ApplyFilter 按关键字在整个书架队列中查询（按最近阅读时间排序）并刷新视图，关键字为空时恢复默认的最近阅读列表，
+// 供标签头的搜索栏调用
+func (r *RecentBooksView) ApplyFilter(keyword string) {
+	r.filter = keyword
+	r.Refresh()
+}
+
 // createBookCard 创建单个书籍卡片
 func (r *RecentBooksView) createBookCard(book bass.BookMeta) fyne.CanvasObject {
 	// 创建封面图片
@@ -74,7 +87,7 @@ func (r *RecentBooksView) createBookCard(book bass.BookMeta) fyne.CanvasObject {
 	coverContainer := container.NewStack(coverImg, coverBorder)
 
 	// 创建书名标签
-	titleText := canvas.NewText(book.Name, color.Black)
+	titleText := canvas.NewText(book.Name, theme.ForegroundColor())
 	titleText.TextSize = theme.TextSize() - 2
 	titleText.TextStyle = fyne.TextStyle{Bold: true}
 
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/18
+ * Time: 20:05
+ * Description: 标签头上可展开的搜索栏
+ */
+
+const searchBarAnimDuration = time.Millisecond * 200 // 搜索栏展开/收起动画时长
+
+// searchOverlay 可展开的搜索栏：点击 searchButton 时以滑下动画出现在标签头之上，
+// 折叠状态下完全隐藏，不参与展开动画之外的布局
+type searchOverlay struct {
+	widget.BaseWidget
+
+	entry *widget.Entry
+
+	expanded  bool
+	progress  float32 // 展开进度，0 为完全收起，1 为完全展开，由动画驱动
+	animation *fyne.Animation
+
+	onChanged func(text string) // 输入变化回调，用于过滤当前激活的标签视图
+}
+
+// newSearchOverlay 创建搜索栏，初始为收起状态
+func newSearchOverlay(onChanged func(text string)) *searchOverlay {
+	s := &searchOverlay{onChanged: onChanged}
+
+	s.entry = widget.NewEntry()
+	s.entry.SetPlaceHolder("搜索书名或分组")
+	s.entry.OnChanged = func(text string) {
+		if s.onChanged != nil {
+			s.onChanged(text)
+		}
+	}
+
+	s.ExtendBaseWidget(s)
+	s.Hide()
+	return s
+}
+
+// Toggle 展开或收起搜索栏
+func (s *searchOverlay) Toggle() {
+	if s.expanded {
+		s.collapse()
+	} else {
+		s.expand()
+	}
+}
+
+// expand 播放滑下动画并在结束后聚焦输入框
+func (s *searchOverlay) expand() {
+	s.expanded = true
+	s.Show()
+	s.playTo(1, func() {
+		if c := fyne.CurrentApp().Driver().CanvasForObject(s.entry); c != nil {
+			c.Focus(s.entry)
+		}
+	})
+}
+
+// collapse 清空输入内容并播放收起动画，结束后隐藏自身
+func (s *searchOverlay) collapse() {
+	s.expanded = false
+	s.entry.SetText("")
+	s.playTo(0, s.Hide)
+}
+
+// playTo 以 ease-out 曲线把展开进度从当前值过渡到 target
+func (s *searchOverlay) playTo(target float32, onDone func()) {
+	if s.animation != nil {
+		s.animation.Stop()
+	}
+	start := s.progress
+	s.animation = fyne.NewAnimation(searchBarAnimDuration, func(p float32) {
+		s.progress = start + (target-start)*p
+		s.Refresh()
+		if p == 1 && onDone != nil {
+			onDone()
+		}
+	})
+	s.animation.Curve = fyne.AnimationEaseOut
+	s.animation.Start()
+}
+
+// CreateRenderer 创建搜索栏的渲染器
+func (s *searchOverlay) CreateRenderer() fyne.WidgetRenderer {
+	return &searchOverlayRenderer{view: s}
+}
+
+// searchOverlayRenderer 按展开进度把输入框从标签头上方滑入
+type searchOverlayRenderer struct {
+	view *searchOverlay
+}
+
+func (r *searchOverlayRenderer) MinSize() fyne.Size {
+	return r.view.entry.MinSize()
+}
+
+func (r *searchOverlayRenderer) Layout(size fyne.Size) {
+	rv := r.view
+	h := rv.entry.MinSize().Height
+	rv.entry.Resize(fyne.NewSize(size.Width, h))
+	rv.entry.Move(fyne.NewPos(0, h*(rv.progress-1)))
+}
+
+func (r *searchOverlayRenderer) Refresh() {
+	r.Layout(r.view.Size())
+	r.view.entry.Refresh()
+}
+
+func (r *searchOverlayRenderer) Objects() []fyne.CanvasObject {
+	return []fyne.CanvasObject{r.view.entry}
+}
+
+func (r *searchOverlayRenderer) Destroy() {}
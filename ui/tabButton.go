@@ -9,6 +9,7 @@ import (
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
@@ -20,22 +21,52 @@ import (
  * Description: 自定义的 tabButton
  */
 
+// TabLocation 标签按钮栏相对内容区域的位置
+type TabLocation int
+
+const (
+	TabLocationTop      TabLocation = iota // 标签栏在内容上方（默认）
+	TabLocationBottom                      // 标签栏在内容下方
+	TabLocationLeading                     // 标签栏在内容左侧，纵向排列
+	TabLocationTrailing                    // 标签栏在内容右侧，纵向排列
+)
+
+// isVertical 标签栏是否按纵向排列
+func (l TabLocation) isVertical() bool {
+	return l == TabLocationLeading || l == TabLocationTrailing
+}
+
 // TabItem 标签项，包含标签的文本、图标和内容
 type TabItem struct {
 	Text    string            // 标签文本
 	Icon    fyne.Resource     // 标签图标（可选）
 	Content fyne.CanvasObject // 标签对应的内容
+
+	Badge      string      // 角标文本，如未读数量，空字符串表示不显示
+	BadgeColor color.Color // 角标底色，未设置时使用 bass.PrimaryColor
 }
 
 // TabContainer 标签容器，管理多个标签项
 type TabContainer struct {
 	widget.BaseWidget
 	Items      []*TabItem      // 标签项列表
+	Location   TabLocation     // 标签栏位置
 	OnSelected func(index int) // 标签选中时的回调函数
-	buttons    []*tabButton    // 标签按钮列表
-	content    *fyne.Container // 内容显示区域
-	currentTab int             // 当前选中的标签索引
-	buttonBox  *fyne.Container // 标签按钮容器
+
+	// CreateTab 设置后，在标签栏末尾渲染一个“+”按钮，点击时调用它创建新标签并追加
+	CreateTab func() *TabItem
+	// CloseIntercept 设置后，关闭标签时改为调用它而不是直接移除
+	CloseIntercept func(*TabItem)
+	// OnClosed 标签被移除后触发（无论是通过 CloseIntercept 还是直接移除）
+	OnClosed func(*TabItem)
+
+	buttons      []*tabButton    // 标签按钮列表
+	content      *fyne.Container // 内容显示区域
+	currentTab   int             // 当前选中的标签索引
+	buttonBox    *fyne.Container // 标签按钮容器
+	createButton *widget.Button  // “+”按钮，CreateTab 为空时不创建
+	overflowBtn  *widget.Button  // 溢出菜单按钮，标签未溢出时隐藏
+	overflowIdx  []int           // 当前被隐藏在溢出菜单里的标签索引
 }
 
 // NewTabContainer 创建新的标签容器
@@ -45,7 +76,7 @@ func NewTabContainer(items ...*TabItem) *TabContainer {
 		content: container.NewMax(),
 	}
 	tc.ExtendBaseWidget(tc)
-	tc.createButtons()
+	tc.rebuildButtons()
 	if len(items) > 0 {
 		tc.content.Objects = []fyne.CanvasObject{items[0].Content}
 		// 默认选中第一个标签
@@ -62,15 +93,89 @@ func NewTabItem(text string, content fyne.CanvasObject) *TabItem {
 	}
 }
 
+// Append 追加一个标签项到容器末尾
+func (tc *TabContainer) Append(item *TabItem) {
+	tc.Items = append(tc.Items, item)
+	tc.rebuildButtons()
+	tc.SelectTab(len(tc.Items) - 1)
+}
+
+// Remove 移除指定的标签项；若设置了 CloseIntercept，则改为调用它由外部决定是否真正关闭
+func (tc *TabContainer) Remove(item *TabItem) {
+	if tc.CloseIntercept != nil {
+		tc.CloseIntercept(item)
+		return
+	}
+	tc.removeItem(item)
+}
+
+// removeItem 直接从容器中移除标签项，并触发 OnClosed
+func (tc *TabContainer) removeItem(item *TabItem) {
+	index := -1
+	for i, it := range tc.Items {
+		if it == item {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return
+	}
+
+	tc.Items = append(tc.Items[:index], tc.Items[index+1:]...)
+	if tc.currentTab >= len(tc.Items) {
+		tc.currentTab = len(tc.Items) - 1
+	}
+	tc.rebuildButtons()
+	if tc.currentTab >= 0 {
+		tc.SelectTab(tc.currentTab)
+	} else {
+		tc.content.Objects = nil
+		tc.content.Refresh()
+	}
+
+	if tc.OnClosed != nil {
+		tc.OnClosed(item)
+	}
+}
+
+// SetBadge 设置指定标签的角标文本，只刷新受影响的按钮；text 为空表示清除角标
+func (tc *TabContainer) SetBadge(index int, text string) {
+	if index < 0 || index >= len(tc.Items) {
+		return
+	}
+	tc.Items[index].Badge = text
+	if index < len(tc.buttons) {
+		tc.buttons[index].Refresh()
+	}
+}
+
+// SetItems 用一组新的标签项整体替换当前内容
+func (tc *TabContainer) SetItems(items []*TabItem) {
+	tc.Items = items
+	tc.rebuildButtons()
+	if len(items) > 0 {
+		tc.SelectTab(0)
+	} else {
+		tc.currentTab = -1
+		tc.content.Objects = nil
+		tc.content.Refresh()
+	}
+}
+
 // tabButton 自定义标签按钮
 type tabButton struct {
 	widget.Button
 	isSelected bool              // 是否选中
+	hovered    bool              // 鼠标是否悬停
 	indicator  *canvas.Rectangle // 底部指示器
 	animation  *fyne.Animation   // 过渡动画
 	container  *TabContainer     // 所属的标签容器
 	index      int               // 在容器中的索引
 	label      *canvas.Text      // 文本标签
+	closeIcon  *canvas.Text      // 悬停时显示的关闭按钮
+	badgeRect  *canvas.Rectangle // 角标底色的圆角矩形
+	badgeText  *canvas.Text      // 角标文字
 }
 
 // newTabButton 创建新的标签按钮
@@ -92,6 +197,18 @@ func newTabButton(text string, icon fyne.Resource, container *TabContainer, inde
 	btn.indicator.Hide()
 	btn.indicator.Resize(fyne.NewSize(40, 2))
 
+	// 创建悬停时的关闭按钮
+	btn.closeIcon = canvas.NewText("×", theme.ForegroundColor())
+	btn.closeIcon.TextSize = theme.TextSize()
+	btn.closeIcon.Hide()
+
+	// 创建角标（底色圆角矩形 + 居中文字），默认隐藏，由 Layout 按 TabItem.Badge 决定是否显示
+	btn.badgeRect = canvas.NewRectangle(bass.PrimaryColor)
+	btn.badgeRect.Hide()
+	btn.badgeText = canvas.NewText("", color.White)
+	btn.badgeText.TextSize = theme.TextSize() - 4
+	btn.badgeText.Hide()
+
 	// 创建动画，控制指示器的位置和透明度
 	btn.animation = fyne.NewAnimation(
 		time.Millisecond*350, // 增加动画时长
@@ -151,12 +268,57 @@ func (t *tabButton) setSelected(selected bool) {
 	t.Refresh()
 }
 
+// MouseIn 实现 desktop.Hoverable，鼠标进入时显示关闭按钮
+func (t *tabButton) MouseIn(*desktop.MouseEvent) {
+	t.hovered = true
+	t.Refresh()
+}
+
+// MouseMoved 实现 desktop.Hoverable
+func (t *tabButton) MouseMoved(*desktop.MouseEvent) {}
+
+// MouseOut 实现 desktop.Hoverable，鼠标离开时隐藏关闭按钮
+func (t *tabButton) MouseOut() {
+	t.hovered = false
+	t.Refresh()
+}
+
+// closeButtonRect 计算关闭按钮在按钮内的可点击矩形，供渲染和点击判定复用
+func (t *tabButton) closeButtonRect() (pos fyne.Position, size fyne.Size) {
+	s := t.Size()
+	size = fyne.NewSize(16, 16)
+	pos = fyne.NewPos(s.Width-size.Width-4, (s.Height-size.Height)/2)
+	return
+}
+
+// Tapped 处理点击事件：命中悬停关闭按钮时关闭标签，否则按普通按钮选中
+func (t *tabButton) Tapped(ev *fyne.PointEvent) {
+	if t.hovered {
+		pos, size := t.closeButtonRect()
+		if ev.Position.X >= pos.X && ev.Position.X <= pos.X+size.Width &&
+			ev.Position.Y >= pos.Y && ev.Position.Y <= pos.Y+size.Height {
+			if t.container != nil && t.index < len(t.container.Items) {
+				t.container.Remove(t.container.Items[t.index])
+			}
+			return
+		}
+	}
+	t.Button.Tapped(ev)
+}
+
+// badgeItem 返回该按钮对应的标签项，用于读取角标文本与颜色
+func (t *tabButton) badgeItem() *TabItem {
+	if t.container == nil || t.index < 0 || t.index >= len(t.container.Items) {
+		return nil
+	}
+	return t.container.Items[t.index]
+}
+
 // CreateRenderer 创建按钮的渲染器
 func (t *tabButton) CreateRenderer() fyne.WidgetRenderer {
 	rend := t.Button.CreateRenderer()
 	objects := rend.Objects()
-	objects = append(objects, t.indicator)
-	objects = append(objects, t.label)
+	objects = append(objects, t.indicator, t.label, t.closeIcon, t.badgeRect, t.badgeText)
 
 	return &tabButtonRenderer{
 		button:         t,
@@ -189,6 +351,53 @@ func (r *tabButtonRenderer) Layout(size fyne.Size) {
 		r.button.indicator.Resize(fyne.NewSize(40, 2))
 		r.button.indicator.Move(fyne.NewPos((size.Width-40)/2, size.Height-2))
 	}
+
+	// 设置悬停关闭按钮位置
+	if r.button.closeIcon != nil {
+		pos, closeSize := r.button.closeButtonRect()
+		r.button.closeIcon.Resize(closeSize)
+		r.button.closeIcon.Move(pos)
+		if r.button.hovered {
+			r.button.closeIcon.Show()
+		} else {
+			r.button.closeIcon.Hide()
+		}
+	}
+
+	r.layoutBadge(size)
+}
+
+// layoutBadge 在按钮右上角绘制角标：当 TabItem.Badge 为空时隐藏角标
+func (r *tabButtonRenderer) layoutBadge(size fyne.Size) {
+	item := r.button.badgeItem()
+	if item == nil || item.Badge == "" {
+		r.button.badgeRect.Hide()
+		r.button.badgeText.Hide()
+		return
+	}
+
+	col := item.BadgeColor
+	if col == nil {
+		col = bass.PrimaryColor
+	}
+
+	r.button.badgeText.Text = item.Badge
+	r.button.badgeText.Color = color.White
+	textSize := r.button.badgeText.MinSize()
+
+	const padding = float32(6)
+	pillSize := fyne.NewSize(textSize.Width+padding*2, textSize.Height+4)
+	pos := fyne.NewPos(size.Width-pillSize.Width-2, 2)
+
+	r.button.badgeRect.FillColor = col
+	r.button.badgeRect.CornerRadius = pillSize.Height / 2
+	r.button.badgeRect.Resize(pillSize)
+	r.button.badgeRect.Move(pos)
+	r.button.badgeRect.Show()
+
+	r.button.badgeText.Resize(textSize)
+	r.button.badgeText.Move(pos.Add(fyne.NewPos(padding, 2)))
+	r.button.badgeText.Show()
 }
 
 func (r *tabButtonRenderer) MinSize() fyne.Size {
@@ -201,15 +410,17 @@ func (r *tabButtonRenderer) Objects() []fyne.CanvasObject {
 
 func (r *tabButtonRenderer) Refresh() {
 	r.buttonRenderer.Refresh()
+	r.Layout(r.button.Size())
 }
 
 func (r *tabButtonRenderer) Destroy() {
 	r.buttonRenderer.Destroy()
 }
 
-// createButtons 为容器创建标签按钮
-func (tc *TabContainer) createButtons() {
+// rebuildButtons 根据当前的 Items 重新生成所有标签按钮及“+”按钮
+func (tc *TabContainer) rebuildButtons() {
 	var buttons []fyne.CanvasObject
+	tc.buttons = nil
 	for i, item := range tc.Items {
 		btn := newTabButton(item.Text, item.Icon, tc, i)
 		btn.OnTapped = func(index int) func() {
@@ -220,7 +431,51 @@ func (tc *TabContainer) createButtons() {
 		tc.buttons = append(tc.buttons, btn)
 		buttons = append(buttons, btn)
 	}
-	tc.buttonBox = container.NewHBox(buttons...)
+
+	if tc.CreateTab != nil {
+		tc.createButton = widget.NewButton("+", func() {
+			item := tc.CreateTab()
+			if item != nil {
+				tc.Append(item)
+			}
+		})
+		tc.createButton.Importance = widget.LowImportance
+		buttons = append(buttons, tc.createButton)
+	}
+
+	tc.overflowBtn = widget.NewButton("⋯", tc.showOverflowMenu)
+	tc.overflowBtn.Importance = widget.LowImportance
+	tc.overflowBtn.Hide()
+	buttons = append(buttons, tc.overflowBtn)
+
+	if tc.Location.isVertical() {
+		tc.buttonBox = container.NewVBox(buttons...)
+	} else {
+		tc.buttonBox = container.NewHBox(buttons...)
+	}
+	tc.Refresh()
+}
+
+// showOverflowMenu 弹出菜单列出当前被隐藏的标签，选中后激活对应标签
+func (tc *TabContainer) showOverflowMenu() {
+	if len(tc.overflowIdx) == 0 {
+		return
+	}
+	var menuItems []*fyne.MenuItem
+	for _, idx := range tc.overflowIdx {
+		index := idx
+		menuItems = append(menuItems, fyne.NewMenuItem(tc.Items[index].Text, func() {
+			tc.SelectTab(index)
+		}))
+	}
+	menu := fyne.NewMenu("", menuItems...)
+	c := fyne.CurrentApp().Driver().CanvasForObject(tc.overflowBtn)
+	if c == nil {
+		return
+	}
+	pos := fyne.CurrentApp().Driver().AbsolutePositionForObject(tc.overflowBtn)
+	pop := widget.NewPopUpMenu(menu, c)
+	pop.ShowAtPosition(pos.Add(fyne.NewPos(0, tc.overflowBtn.Size().Height)))
 }
 
 // SelectTab 选择指定索引的标签
@@ -242,11 +497,27 @@ func (tc *TabContainer) SelectTab(index int) {
 	tc.content.Objects = []fyne.CanvasObject{tc.Items[index].Content}
 	tc.content.Refresh()
 
+	tc.ensureButtonVisible(index)
+
 	if tc.OnSelected != nil {
 		tc.OnSelected(index)
 	}
 }
 
+// ensureButtonVisible 若选中的标签当前隐藏在溢出菜单里，强制显示其按钮
+func (tc *TabContainer) ensureButtonVisible(index int) {
+	for i, idx := range tc.overflowIdx {
+		if idx != index {
+			continue
+		}
+		tc.overflowIdx = append(tc.overflowIdx[:i], tc.overflowIdx[i+1:]...)
+		if index < len(tc.buttons) {
+			tc.buttons[index].Show()
+		}
+		return
+	}
+}
+
 // setSelectedTab 内部方法，设置选中的标签
 func (tc *TabContainer) setSelectedTab(index int) {
 	if tc.currentTab == index {
@@ -277,14 +548,115 @@ func (r *tabContainerRenderer) MinSize() fyne.Size {
 }
 
 func (r *tabContainerRenderer) Layout(size fyne.Size) {
-	buttonHeight := r.container.buttonBox.MinSize().Height
-	r.container.buttonBox.Resize(fyne.NewSize(size.Width, buttonHeight))
-	r.container.buttonBox.Move(fyne.NewPos(0, 0))
+	tc := r.container
+	vertical := tc.Location.isVertical()
 
-	if r.container.content != nil {
+	r.layoutOverflow(size, vertical)
+
+	if vertical {
+		buttonWidth := tc.buttonBox.MinSize().Width
+		var x float32
+		if tc.Location == TabLocationTrailing {
+			x = size.Width - buttonWidth
+		}
+		tc.buttonBox.Resize(fyne.NewSize(buttonWidth, size.Height))
+		tc.buttonBox.Move(fyne.NewPos(x, 0))
+
+		if tc.content != nil {
+			contentX := buttonWidth
+			if tc.Location == TabLocationTrailing {
+				contentX = 0
+			}
+			tc.content.Resize(fyne.NewSize(size.Width-buttonWidth, size.Height))
+			tc.content.Move(fyne.NewPos(contentX, 0))
+		}
+		return
+	}
+
+	buttonHeight := tc.buttonBox.MinSize().Height
+	var y float32
+	if tc.Location == TabLocationBottom {
+		y = size.Height - buttonHeight
+	}
+	tc.buttonBox.Resize(fyne.NewSize(size.Width, buttonHeight))
+	tc.buttonBox.Move(fyne.NewPos(0, y))
+
+	if tc.content != nil {
 		contentY := buttonHeight
-		r.container.content.Resize(fyne.NewSize(size.Width, size.Height-contentY))
-		r.container.content.Move(fyne.NewPos(0, contentY))
+		if tc.Location == TabLocationBottom {
+			contentY = 0
+		}
+		tc.content.Resize(fyne.NewSize(size.Width, size.Height-buttonHeight))
+		tc.content.Move(fyne.NewPos(0, contentY))
+	}
+}
+
+// layoutOverflow 根据可用空间计算哪些标签按钮需要被折叠进溢出菜单
+func (r *tabContainerRenderer) layoutOverflow(size fyne.Size, vertical bool) {
+	tc := r.container
+	available := size.Width
+	if vertical {
+		available = size.Height
+	}
+
+	reserved := float32(0)
+	if tc.createButton != nil {
+		if vertical {
+			reserved += tc.createButton.MinSize().Height
+		} else {
+			reserved += tc.createButton.MinSize().Width
+		}
+	}
+
+	total := reserved
+	for _, btn := range tc.buttons {
+		if vertical {
+			total += btn.MinSize().Height
+		} else {
+			total += btn.MinSize().Width
+		}
+	}
+
+	if total <= available {
+		tc.overflowIdx = nil
+		tc.overflowBtn.Hide()
+		for _, btn := range tc.buttons {
+			btn.Show()
+		}
+		return
+	}
+
+	overflowSize := float32(0)
+	if vertical {
+		overflowSize = tc.overflowBtn.MinSize().Height
+	} else {
+		overflowSize = tc.overflowBtn.MinSize().Width
+	}
+	budget := available - reserved - overflowSize
+
+	var used float32
+	var overflow []int
+	for i, btn := range tc.buttons {
+		var w float32
+		if vertical {
+			w = btn.MinSize().Height
+		} else {
+			w = btn.MinSize().Width
+		}
+		if i == tc.currentTab || used+w <= budget {
+			btn.Show()
+			used += w
+			continue
+		}
+		btn.Hide()
+		overflow = append(overflow, i)
+	}
+
+	tc.overflowIdx = overflow
+	if len(overflow) > 0 {
+		tc.overflowBtn.Show()
+	} else {
+		tc.overflowBtn.Hide()
 	}
 }
 
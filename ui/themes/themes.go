@@ -0,0 +1,54 @@
+package themes
+
+import (
+	"image/color"
+
+	"goread/bass"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+/**
+ * @author: zhashut
+ * Date: 2025/4/18
+ * Time: 20:30
+ * Description: 浅色/深色主题，主色调可通过 ThemeConfig 自定义
+ */
+
+// ThemeConfig 主题可配置项；PrimaryColor 为空时回退到 bass.PrimaryColor
+type ThemeConfig struct {
+	PrimaryColor color.Color // 主题主色调，用于按钮、指示器等强调元素
+}
+
+// appTheme 在内置明暗主题基础上，用 ThemeConfig.PrimaryColor 覆盖主色调，其余外观沿用内置主题
+type appTheme struct {
+	fyne.Theme
+	config ThemeConfig
+}
+
+// LightTheme 创建浅色主题
+func LightTheme(config ThemeConfig) fyne.Theme {
+	return newAppTheme(theme.LightTheme(), config)
+}
+
+// DarkTheme 创建深色主题
+func DarkTheme(config ThemeConfig) fyne.Theme {
+	return newAppTheme(theme.DarkTheme(), config)
+}
+
+// newAppTheme 用 config 包装 base，config.PrimaryColor 为空时回退到 bass.PrimaryColor
+func newAppTheme(base fyne.Theme, config ThemeConfig) fyne.Theme {
+	if config.PrimaryColor == nil {
+		config.PrimaryColor = bass.PrimaryColor
+	}
+	return &appTheme{Theme: base, config: config}
+}
+
+// Color 覆盖主色调，其余颜色查询转发给内置主题
+func (t *appTheme) Color(name fyne.ThemeColorName, variant fyne.ThemeVariant) color.Color {
+	if name == theme.ColorNamePrimary {
+		return t.config.PrimaryColor
+	}
+	return t.Theme.Color(name, variant)
+}